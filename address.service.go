@@ -0,0 +1,168 @@
+package gofo
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hiscaler/gofo-go/entity"
+)
+
+// 地址服务
+type addressService service
+
+// AddressValidationResult 地址校验结果
+type AddressValidationResult struct {
+	Address        OrderConsignee             `json:"standardizedAddress"` // 标准化后的地址
+	Corrections    []entity.AddressCorrection `json:"corrections"`         // 字段修正
+	Deliverability string                     `json:"deliverability"`      // 可派送性, 参见 entity.DeliverabilityXXX
+	AddressType    string                     `json:"addressType"`         // 地址类型, 参见 entity.AddressTypeXXX
+	Offline        bool                       `json:"-"`                   // 是否为本地校验结果(启用 WithOfflineFallback 且 GOFO 地址校验网络不可用时)
+}
+
+// AddressValidationOption 地址校验选项
+type AddressValidationOption func(*addressValidationOptions)
+
+type addressValidationOptions struct {
+	offlineFallback bool // 网络不可用时是否使用本地规则校验
+}
+
+// WithOfflineFallback 调用 GOFO 地址校验出现网络错误(超时、DNS 解析失败、连接失败等)时, 使用本地规则进行标准化
+// 认证失败、限流及业务错误等仍会直接返回错误
+func WithOfflineFallback() AddressValidationOption {
+	return func(o *addressValidationOptions) {
+		o.offlineFallback = true
+	}
+}
+
+// isTransportError 是否为网络传输错误
+func isTransportError(err error) bool {
+	var netErr net.Error
+	return errors.As(err, &netErr)
+}
+
+// Residential 是否为住宅地址
+func (r AddressValidationResult) Residential() bool {
+	return r.AddressType == entity.AddressTypeResidential
+}
+
+// ApplySafeCorrections 将安全修正应用到收件人信息
+func (r AddressValidationResult) ApplySafeCorrections(consignee OrderConsignee) OrderConsignee {
+	for _, c := range r.Corrections {
+		if c.Safe {
+			consignee = consignee.withField(c.Field, c.Corrected)
+		}
+	}
+	return consignee
+}
+
+// withField 按 JSON 字段名称设置收件人地址字段
+func (m OrderConsignee) withField(field, value string) OrderConsignee {
+	switch field {
+	case "consigneeCountry":
+		m.ConsigneeCountry = value
+	case "consigneeState":
+		m.ConsigneeState = value
+	case "consigneeCity":
+		m.ConsigneeCity = value
+	case "consigneeArea":
+		m.ConsigneeArea.SetValid(value)
+	case "address1":
+		m.Address1 = value
+	case "address2":
+		m.Address2.SetValid(value)
+	case "address3":
+		m.Address3.SetValid(value)
+	case "consigneeCode":
+		m.ConsigneeCode = value
+	}
+	return m
+}
+
+// Validate 地址校验
+// 启用 WithOfflineFallback 时, GOFO 地址校验网络不可用则使用本地规则进行标准化, 此时 Offline 为 true
+func (s addressService) Validate(ctx context.Context, consignee OrderConsignee, opts ...AddressValidationOption) (AddressValidationResult, error) {
+	options := addressValidationOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	// 待校验的地址可能尚未标准化, 此处仅检查必填项
+	err := validation.ValidateStruct(&consignee,
+		validation.Field(&consignee.ConsigneeCountry, validation.Required.Error("收件人国家不能为空")),
+		validation.Field(&consignee.Address1, validation.Required.Error("收件地址 1 不能为空")),
+	)
+	if err != nil {
+		return AddressValidationResult{}, invalidInput(s.locale, err)
+	}
+
+	var res struct {
+		NormalResponse
+		Data AddressValidationResult `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetBody(consignee).
+		SetResult(&res).
+		Post("/open-api/v2/address/check")
+	if err != nil && options.offlineFallback && ctx.Err() == nil && isTransportError(err) {
+		s.logger.Warn("GOFO address check unavailable, fallback to offline validation", "error", err)
+		return offlineValidateAddress(consignee), nil
+	}
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return AddressValidationResult{}, err
+	}
+	return res.Data, nil
+}
+
+// offlineValidateAddress 本地地址标准化
+func offlineValidateAddress(consignee OrderConsignee) AddressValidationResult {
+	result := AddressValidationResult{
+		Address:        consignee,
+		Deliverability: entity.DeliverabilityUnknown,
+		Offline:        true,
+	}
+	correct := func(field, original, corrected string) {
+		if corrected == original {
+			return
+		}
+		result.Corrections = append(result.Corrections, entity.AddressCorrection{
+			Field:     field,
+			Original:  original,
+			Corrected: corrected,
+			Safe:      true,
+		})
+		result.Address = result.Address.withField(field, corrected)
+	}
+	clean := func(s string) string {
+		return strings.Join(strings.Fields(s), " ")
+	}
+
+	correct("consigneeCountry", consignee.ConsigneeCountry, strings.ToUpper(clean(consignee.ConsigneeCountry)))
+	state := clean(consignee.ConsigneeState)
+	if len(state) == 2 {
+		state = strings.ToUpper(state)
+	}
+	correct("consigneeState", consignee.ConsigneeState, state)
+	correct("consigneeCity", consignee.ConsigneeCity, clean(consignee.ConsigneeCity))
+	correct("address1", consignee.Address1, clean(consignee.Address1))
+	if consignee.Address2.Valid {
+		correct("address2", consignee.Address2.String, clean(consignee.Address2.String))
+	}
+	if consignee.Address3.Valid {
+		correct("address3", consignee.Address3.String, clean(consignee.Address3.String))
+	}
+	correct("consigneeCode", consignee.ConsigneeCode, strings.ToUpper(strings.ReplaceAll(consignee.ConsigneeCode, " ", "")))
+
+	address, corrections, err := result.Address.NormalizeUS()
+	if err != nil {
+		result.Deliverability = entity.DeliverabilityUndeliverable
+		return result
+	}
+	for _, c := range corrections {
+		correct(c.Field, c.Original, c.Corrected)
+	}
+	result.Address = address
+	return result
+}
@@ -0,0 +1,106 @@
+package gofo
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// AddressRule 国家地址规则, 同时适用于发件人及收件人地址
+type AddressRule struct {
+	PostcodePattern *regexp.Regexp // 邮编格式, 为空时不校验
+	PostcodeFormat  string         // 邮编格式说明, 用于错误信息, 例如 "5 位数字"
+	StatePattern    *regexp.Regexp // 省/州格式, 为空时不校验
+	StateFormat     string         // 省/州格式说明, 用于错误信息
+	NumExtRequired  bool           // 是否必须提供外门牌号(仅校验收件人地址)
+}
+
+var addressRules = struct {
+	sync.RWMutex
+	rules map[string]AddressRule
+}{
+	rules: map[string]AddressRule{
+		"US": {
+			PostcodePattern: regexp.MustCompile(`^\d{5}(-\d{4})?$`),
+			PostcodeFormat:  "5 位数字(或 ZIP+4 格式)",
+			StatePattern:    regexp.MustCompile(`^[A-Z]{2}$`),
+			StateFormat:     "2 位大写字母的州代码",
+		},
+		"MX": {
+			PostcodePattern: regexp.MustCompile(`^\d{5}$`),
+			PostcodeFormat:  "5 位数字",
+			NumExtRequired:  true,
+		},
+		"CN": {
+			PostcodePattern: regexp.MustCompile(`^\d{6}$`),
+			PostcodeFormat:  "6 位数字",
+		},
+	},
+}
+
+// countryAliases 内置地址规则国家的常见别名(三字码及国家名称), 美国别名参见 isUSCountry
+var countryAliases = map[string]string{
+	"MEX":                        "MX",
+	"MEXICO":                     "MX",
+	"MÉXICO":                     "MX",
+	"UNITED MEXICAN STATES":      "MX",
+	"ESTADOS UNIDOS MEXICANOS":   "MX",
+	"CHN":                        "CN",
+	"CHINA":                      "CN",
+	"PRC":                        "CN",
+	"PEOPLE'S REPUBLIC OF CHINA": "CN",
+}
+
+// normalizeCountry 国家二字码
+func normalizeCountry(country string) string {
+	if isUSCountry(country) {
+		return "US"
+	}
+	country = strings.ToUpper(strings.Join(strings.Fields(country), " "))
+	if code, ok := countryAliases[country]; ok {
+		return code
+	}
+	return country
+}
+
+// RegisterAddressRule 注册(或覆盖)国家地址规则
+// @param country 国家二字码, 例如 US
+func RegisterAddressRule(country string, rule AddressRule) {
+	addressRules.Lock()
+	addressRules.rules[normalizeCountry(country)] = rule
+	addressRules.Unlock()
+}
+
+// LookupAddressRule 获取国家地址规则
+func LookupAddressRule(country string) (AddressRule, bool) {
+	addressRules.RLock()
+	defer addressRules.RUnlock()
+	rule, ok := addressRules.rules[normalizeCountry(country)]
+	return rule, ok
+}
+
+// postcodeRule 邮编校验规则
+// @param name 字段名称, 用于错误信息
+func (r AddressRule) postcodeRule(name string) validation.Rule {
+	return validation.By(func(value interface{}) error {
+		s, _ := value.(string)
+		if s == "" || r.PostcodePattern == nil || r.PostcodePattern.MatchString(s) {
+			return nil
+		}
+		return validation.NewError("validation_postcode_invalid", "{{.field}}必须为 {{.format}}").SetParams(map[string]interface{}{"field": name, "format": r.PostcodeFormat})
+	})
+}
+
+// stateRule 省/州校验规则
+// @param name 字段名称, 用于错误信息
+func (r AddressRule) stateRule(name string) validation.Rule {
+	return validation.By(func(value interface{}) error {
+		s, _ := value.(string)
+		if s == "" || r.StatePattern == nil || r.StatePattern.MatchString(s) {
+			return nil
+		}
+		return validation.NewError("validation_state_invalid", "{{.field}}必须为 {{.format}}").SetParams(map[string]interface{}{"field": name, "format": r.StateFormat})
+	})
+}
@@ -0,0 +1,46 @@
+package gofo
+
+import (
+	"regexp"
+	"testing"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestOrderConsignee_AddressRule(t *testing.T) {
+	consignee := OrderConsignee{
+		ConsigneeName:    "test",
+		ConsigneeCountry: "MX",
+		ConsigneeState:   "CDMX",
+		ConsigneeCity:    "Ciudad de Mexico",
+		Address1:         "Av. Reforma",
+		ConsigneeCode:    "06600",
+	}
+	if err := consignee.Validate(); err == nil {
+		t.Errorf("Expected exterior number error")
+	}
+	for _, country := range []string{"Mexico", "MEX", " méxico "} {
+		consignee.ConsigneeCountry = country
+		if err := consignee.Validate(); err == nil {
+			t.Errorf("Expected exterior number error for country %q", country)
+		}
+	}
+	if rule, ok := LookupAddressRule("People's Republic of China"); !ok || rule.PostcodeFormat != "6 位数字" {
+		t.Errorf("Expected CN address rule for country name")
+	}
+	consignee.ConsigneeNumExt = null.StringFrom("222")
+	if err := consignee.Validate(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+
+	consignee.ConsigneeCountry = "US"
+	if err := consignee.Validate(); err == nil {
+		t.Errorf("Expected US state error")
+	}
+
+	RegisterAddressRule("JP", AddressRule{PostcodePattern: regexp.MustCompile(`^\d{3}-\d{4}$`), PostcodeFormat: "NNN-NNNN"})
+	consignee.ConsigneeCountry = "JP"
+	if err := consignee.Validate(); err == nil {
+		t.Errorf("Expected JP postcode error")
+	}
+}
@@ -0,0 +1,36 @@
+package gofo
+
+import (
+	"fmt"
+	"net"
+	"testing"
+)
+
+func TestAddressService_Validate(t *testing.T) {
+	consignee := OrderConsignee{
+		ConsigneeName:    "test",
+		ConsigneePhone:   "13000000000",
+		ConsigneeCountry: "us",
+		ConsigneeState:   "ca",
+		ConsigneeCity:    " Los  Angeles ",
+		Address1:         "test address",
+		ConsigneeCode:    "90001",
+	}
+	result, err := client.Services.Address.Validate(ctx, consignee, WithOfflineFallback())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if result.Offline && result.Address.ConsigneeCity != "Los Angeles" {
+		t.Errorf("Expected city Los Angeles, got %q", result.Address.ConsigneeCity)
+	}
+	fmt.Println(result)
+}
+
+func TestIsTransportError(t *testing.T) {
+	if !isTransportError(&net.DNSError{Err: "no such host", Name: "example.com"}) {
+		t.Errorf("Expected DNS error to be a transport error")
+	}
+	if isTransportError(errorWrap(LocaleZhCN, 401, "")) {
+		t.Errorf("Expected authentication error not to be a transport error")
+	}
+}
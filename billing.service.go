@@ -0,0 +1,93 @@
+package gofo
+
+import (
+	"context"
+	"time"
+
+	"github.com/hiscaler/gofo-go/entity"
+)
+
+// 账单服务
+type billingService service
+
+// WaybillCharges 运单费用明细查询
+// @param waybillNo GOFO 的运单号
+func (s billingService) WaybillCharges(ctx context.Context, waybillNo string) ([]entity.Charge, error) {
+	if waybillNo == "" {
+		return nil, localizedError(s.locale, "运单号不能为空")
+	}
+
+	var res struct {
+		NormalResponse
+		Data []entity.Charge `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetQueryParam("waybillNo", waybillNo).
+		SetResult(&res).
+		Get("/open-api/v2/billing/waybill")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
+
+// PeriodCharges 账单周期费用明细查询, 自动获取所有分页数据
+// @param from 计费开始时间
+// @param to 计费结束时间
+func (s billingService) PeriodCharges(ctx context.Context, from, to time.Time) ([]entity.Charge, error) {
+	if !to.After(from) {
+		return nil, localizedError(s.locale, "计费结束时间必须晚于开始时间")
+	}
+
+	const pageSize = 100
+	charges := make([]entity.Charge, 0)
+	for pageNo := 1; ; pageNo++ {
+		var res struct {
+			NormalResponse
+			Data struct {
+				Total   int             `json:"total"`
+				Records []entity.Charge `json:"records"`
+			} `json:"data"`
+		}
+		resp, err := s.httpClient.R().
+			SetContext(ctx).
+			SetBody(map[string]any{
+				"startTime": NewDateTime(from).In(s.location),
+				"endTime":   NewDateTime(to).In(s.location),
+				"pageNo":    pageNo,
+				"pageSize":  pageSize,
+			}).
+			SetResult(&res).
+			Post("/open-api/v2/billing/list")
+		if err = recheckError(s.locale, resp, err); err != nil {
+			return nil, err
+		}
+
+		charges = append(charges, res.Data.Records...)
+		if len(res.Data.Records) < pageSize || len(charges) >= res.Data.Total {
+			return charges, nil
+		}
+	}
+}
+
+// ReconcileCharges 费用对账
+// 对比我方订单, 标记不是由我方创建的运单费用, 以及已通过 Order.Cancel 取消的运单费用
+func ReconcileCharges(charges []entity.Charge, orders []entity.Order) []entity.ChargeIssue {
+	statuses := make(map[string]string, len(orders))
+	for _, order := range orders {
+		statuses[order.WaybillNo] = order.Status
+	}
+
+	issues := make([]entity.ChargeIssue, 0)
+	for _, charge := range charges {
+		status, ok := statuses[charge.WaybillNo]
+		switch {
+		case !ok:
+			issues = append(issues, entity.ChargeIssue{Charge: charge, Issue: entity.ChargeIssueUnknownWaybill})
+		case status == entity.OrderStatusCancelled:
+			issues = append(issues, entity.ChargeIssue{Charge: charge, Issue: entity.ChargeIssueCancelledWaybill})
+		}
+	}
+	return issues
+}
@@ -0,0 +1,45 @@
+package gofo
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/hiscaler/gofo-go/entity"
+)
+
+func TestBillingService_WaybillCharges(t *testing.T) {
+	charges, err := client.Services.Billing.WaybillCharges(ctx, "GFUS01014625997824")
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	fmt.Println(charges)
+}
+
+func TestBillingService_PeriodCharges(t *testing.T) {
+	to := time.Now()
+	charges, err := client.Services.Billing.PeriodCharges(ctx, to.AddDate(0, -1, 0), to)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	fmt.Println(charges)
+}
+
+func TestReconcileCharges(t *testing.T) {
+	charges := []entity.Charge{
+		{WaybillNo: "GF001", ChargeType: entity.ChargeTypeFreight, Amount: 5},
+		{WaybillNo: "GF002", ChargeType: entity.ChargeTypeFreight, Amount: 5},
+		{WaybillNo: "GF003", ChargeType: entity.ChargeTypeFuel, Amount: 1},
+	}
+	orders := []entity.Order{
+		{WaybillNo: "GF001", Status: entity.OrderStatusCreated},
+		{WaybillNo: "GF002", Status: entity.OrderStatusCancelled},
+	}
+	issues := ReconcileCharges(charges, orders)
+	if len(issues) != 2 {
+		t.Fatalf("Expected 2 issues, got %d", len(issues))
+	}
+	if issues[0].Issue != entity.ChargeIssueCancelledWaybill || issues[1].Issue != entity.ChargeIssueUnknownWaybill {
+		t.Errorf("Unexpected issues %v", issues)
+	}
+}
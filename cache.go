@@ -0,0 +1,94 @@
+package gofo
+
+import (
+	"container/list"
+	"sync"
+	"time"
+)
+
+const (
+	memoryCacheMaxItems      = 10000            // 默认最大缓存条数
+	memoryCacheSweepInterval = 10 * time.Minute // 过期缓存清理间隔
+)
+
+type cacheItem struct {
+	key       string
+	value     any
+	expiresAt time.Time
+}
+
+// memoryCache 本地内存缓存
+// 每隔 memoryCacheSweepInterval 在写入时清理过期缓存, 达到最大条数时淘汰最早写入的缓存
+type memoryCache struct {
+	mu        sync.RWMutex
+	items     map[string]*list.Element // 值为 *cacheItem
+	order     *list.List               // 按写入顺序排列的缓存, 最早写入的在前
+	maxItems  int                      // 最大缓存条数
+	lastSweep time.Time                // 上次清理过期缓存的时间
+}
+
+func newMemoryCache() *memoryCache {
+	return &memoryCache{items: make(map[string]*list.Element), order: list.New(), maxItems: memoryCacheMaxItems, lastSweep: time.Now()}
+}
+
+// Get 获取缓存, 过期或不存在时返回 false
+func (c *memoryCache) Get(key string) (any, bool) {
+	c.mu.RLock()
+	e, ok := c.items[key]
+	var item cacheItem
+	if ok {
+		item = *e.Value.(*cacheItem)
+	}
+	c.mu.RUnlock()
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(item.expiresAt) {
+		c.mu.Lock()
+		// 加锁期间可能已被重新设置
+		if e, ok = c.items[key]; ok && time.Now().After(e.Value.(*cacheItem).expiresAt) {
+			c.remove(e)
+		}
+		c.mu.Unlock()
+		return nil, false
+	}
+	return item.value, true
+}
+
+// Set 设置缓存
+func (c *memoryCache) Set(key string, value any, ttl time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	now := time.Now()
+	if now.Sub(c.lastSweep) >= memoryCacheSweepInterval {
+		c.sweep(now)
+	}
+	if e, ok := c.items[key]; ok {
+		c.remove(e)
+	}
+	if c.maxItems > 0 {
+		for len(c.items) >= c.maxItems {
+			c.remove(c.order.Front())
+		}
+	}
+	c.items[key] = c.order.PushBack(&cacheItem{key: key, value: value, expiresAt: now.Add(ttl)})
+}
+
+// remove 删除缓存, 调用方需持有写锁
+func (c *memoryCache) remove(e *list.Element) {
+	c.order.Remove(e)
+	delete(c.items, e.Value.(*cacheItem).key)
+}
+
+// sweep 清理过期缓存, 调用方需持有写锁
+func (c *memoryCache) sweep(now time.Time) {
+	for e := c.order.Front(); e != nil; {
+		next := e.Next()
+		if now.After(e.Value.(*cacheItem).expiresAt) {
+			c.remove(e)
+		}
+		e = next
+	}
+	c.lastSweep = now
+}
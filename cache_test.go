@@ -0,0 +1,40 @@
+package gofo
+
+import (
+	"fmt"
+	"testing"
+	"time"
+)
+
+func TestMemoryCache(t *testing.T) {
+	c := newMemoryCache()
+	c.maxItems = 3
+	c.Set("expired", 1, -time.Second)
+	if _, ok := c.Get("expired"); ok {
+		t.Errorf("Expected expired item not to be returned")
+	}
+	for i := 0; i < 3; i++ {
+		c.Set(fmt.Sprintf("key%d", i), i, time.Hour)
+	}
+	// 重新写入的缓存移到最后, 淘汰最早写入的 key1
+	c.Set("key0", 0, time.Hour)
+	c.Set("key3", 3, time.Hour)
+	if len(c.items) != 3 || c.order.Len() != 3 {
+		t.Errorf("Expected 3 items, got %d", len(c.items))
+	}
+	if _, ok := c.Get("key1"); ok {
+		t.Errorf("Expected earliest written item to be evicted")
+	}
+	for _, key := range []string{"key0", "key2", "key3"} {
+		if _, ok := c.Get(key); !ok {
+			t.Errorf("Expected %s to be cached", key)
+		}
+	}
+
+	c.lastSweep = time.Now().Add(-memoryCacheSweepInterval)
+	c.items["key2"].Value.(*cacheItem).expiresAt = time.Now().Add(-time.Second)
+	c.Set("key3", 3, time.Hour)
+	if _, ok := c.items["key2"]; ok || c.order.Len() != 2 {
+		t.Errorf("Expected periodic sweep to remove expired item")
+	}
+}
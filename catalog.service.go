@@ -0,0 +1,128 @@
+package gofo
+
+import (
+	"context"
+	"slices"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hiscaler/gofo-go/entity"
+)
+
+// 产品目录服务
+type catalogService service
+
+// catalogCacheTTL 产品及入口岸目录缓存时间
+const catalogCacheTTL = 24 * time.Hour
+
+// Catalog 产品及入口岸目录, 可通过 CreateOrderRequest.WithCatalog 用于订单校验
+type Catalog struct {
+	Products   []entity.Product   // 产品
+	EntryPorts []entity.EntryPort // 入口岸
+}
+
+// Product 根据产品编码查找产品
+func (c Catalog) Product(code string) (entity.Product, bool) {
+	for _, product := range c.Products {
+		if product.ProductCode == code {
+			return product, true
+		}
+	}
+	return entity.Product{}, false
+}
+
+// EntryPort 根据入口岸编码查找入口岸
+func (c Catalog) EntryPort(code string) (entity.EntryPort, bool) {
+	for _, port := range c.EntryPorts {
+		if port.Code == code {
+			return port, true
+		}
+	}
+	return entity.EntryPort{}, false
+}
+
+// checkProduct 检查产品是否存在, 以及配送类型是否可用
+func (c Catalog) checkProduct(code, shippingType string) error {
+	product, ok := c.Product(code)
+	if !ok {
+		return validation.NewError("validation_product_not_found", "产品编码 {{.code}} 不存在").SetParams(map[string]interface{}{"code": code})
+	}
+	if shippingType != "" && len(product.ShippingTypes) > 0 && !slices.Contains(product.ShippingTypes, shippingType) {
+		return validation.NewError("validation_shipping_type_unsupported", "产品 {{.code}} 不支持配送类型 {{.shippingType}}").SetParams(map[string]interface{}{"code": code, "shippingType": shippingType})
+	}
+	return nil
+}
+
+// checkEntryPort 检查入口岸是否存在
+func (c Catalog) checkEntryPort(code string) error {
+	if _, ok := c.EntryPort(code); !ok {
+		return validation.NewError("validation_entry_port_not_found", "入口岸 {{.code}} 不存在").SetParams(map[string]interface{}{"code": code})
+	}
+	return nil
+}
+
+// cloneProducts 复制产品列表, 避免调用方修改缓存中的数据
+func cloneProducts(products []entity.Product) []entity.Product {
+	products = slices.Clone(products)
+	for i := range products {
+		products[i].ShippingTypes = slices.Clone(products[i].ShippingTypes)
+	}
+	return products
+}
+
+// Products 产品列表
+func (s catalogService) Products(ctx context.Context) ([]entity.Product, error) {
+	const key = "catalog:products"
+	if v, ok := s.cache.Get(key); ok {
+		return cloneProducts(v.([]entity.Product)), nil
+	}
+
+	var res struct {
+		NormalResponse
+		Data []entity.Product `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetResult(&res).
+		Get("/open-api/v2/product/list")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return nil, err
+	}
+	s.cache.Set(key, cloneProducts(res.Data), catalogCacheTTL)
+	return res.Data, nil
+}
+
+// EntryPorts 入口岸列表
+func (s catalogService) EntryPorts(ctx context.Context) ([]entity.EntryPort, error) {
+	const key = "catalog:entryPorts"
+	if v, ok := s.cache.Get(key); ok {
+		return slices.Clone(v.([]entity.EntryPort)), nil
+	}
+
+	var res struct {
+		NormalResponse
+		Data []entity.EntryPort `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetResult(&res).
+		Get("/open-api/v2/entryPort/list")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return nil, err
+	}
+	s.cache.Set(key, slices.Clone(res.Data), catalogCacheTTL)
+	return res.Data, nil
+}
+
+// Load 获取产品及入口岸目录
+func (s catalogService) Load(ctx context.Context) (Catalog, error) {
+	products, err := s.Products(ctx)
+	if err != nil {
+		return Catalog{}, err
+	}
+	ports, err := s.EntryPorts(ctx)
+	if err != nil {
+		return Catalog{}, err
+	}
+	return Catalog{Products: products, EntryPorts: ports}, nil
+}
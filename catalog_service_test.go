@@ -0,0 +1,75 @@
+package gofo
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hiscaler/gofo-go/entity"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestCatalogService_Products(t *testing.T) {
+	products, err := client.Services.Catalog.Products(ctx)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	fmt.Println(products)
+}
+
+func TestCatalogService_EntryPorts(t *testing.T) {
+	ports, err := client.Services.Catalog.EntryPorts(ctx)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	fmt.Println(ports)
+}
+
+func TestCatalogService_ProductsCopy(t *testing.T) {
+	requests := 0
+	svc := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"code":200,"data":[{"productCode":"GOFO_GROUND","shippingTypes":["HDN","ZT"]}]}`)
+	})
+	s := catalogService(svc)
+
+	products, err := s.Products(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	products[0].ProductCode = "CHANGED"
+	products[0].ShippingTypes[0] = "CHANGED"
+
+	cached, err := s.Products(ctx)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if requests != 1 {
+		t.Errorf("Expected products to be cached, got %d requests", requests)
+	}
+	if cached[0].ProductCode != "GOFO_GROUND" || cached[0].ShippingTypes[0] != "HDN" {
+		t.Errorf("Expected cached products to be unaffected, got %+v", cached[0])
+	}
+}
+
+func TestCreateOrderRequest_WithCatalog(t *testing.T) {
+	catalog := Catalog{
+		Products:   []entity.Product{{ProductCode: "GOFO Parcel Pickup", ShippingTypes: []string{"HDN"}}},
+		EntryPorts: []entity.EntryPort{{Code: "LAX"}},
+	}
+	req := CreateOrderRequest{
+		ProductCode: null.StringFrom("UNKNOWN"),
+		EntryPort:   "JFK",
+	}.WithCatalog(catalog)
+	err := req.Validate()
+	var errs validation.Errors
+	if !errors.As(err, &errs) {
+		t.Fatalf("Expected validation errors, got %v", err)
+	}
+	if errs["productCode"] == nil || errs["entryPort"] == nil {
+		t.Errorf("Expected productCode and entryPort errors, got %v", errs)
+	}
+}
@@ -0,0 +1,105 @@
+package gofo
+
+import (
+	"context"
+	"fmt"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hiscaler/gofo-go/entity"
+	"gopkg.in/guregu/null.v4"
+)
+
+// 索赔服务
+type claimService service
+
+// ClaimAttachment 索赔凭证
+type ClaimAttachment struct {
+	FileName string `json:"fileName"`   // 文件名, 长度 1-100
+	Data     []byte `json:"base64code"` // 文件内容
+}
+
+func (m ClaimAttachment) Validate() error {
+	return validation.ValidateStruct(&m,
+		validation.Field(&m.FileName, validation.Required.Error("凭证文件名不能为空"), validation.Length(1, 100).Error("凭证文件名长度必须在 {{.min}}-{{.max}} 之间")),
+		validation.Field(&m.Data, validation.Required.Error("凭证文件内容不能为空")),
+	)
+}
+
+// CreateClaimRequest 索赔请求
+type CreateClaimRequest struct {
+	WaybillNo      string            `json:"waybillNo"`             // GOFO 的运单号
+	Reason         string            `json:"reason"`                // 索赔原因: LOST(丢失), DAMAGED(破损)
+	Amount         float64           `json:"amount"`                // 索赔金额, 单位: 美金
+	Description    null.String       `json:"description,omitempty"` // 情况说明, 长度 1-500
+	Attachments    []ClaimAttachment `json:"attachments"`           // 凭证附件
+	DeclaredValue  float64           `json:"-"`                     // 原订单包裹预报货值, 用于校验索赔金额, 原订单未保价时必填
+	OrderInsurance *OrderInsurance   `json:"-"`                     // 原订单保价, 用于校验索赔金额
+}
+
+// maxAmount 最大可索赔金额, 有保价时为保价金额, 否则为预报货值
+func (m CreateClaimRequest) maxAmount() float64 {
+	if m.OrderInsurance != nil && m.OrderInsurance.InsuredAmount > 0 {
+		return m.OrderInsurance.InsuredAmount
+	}
+	return m.DeclaredValue
+}
+
+func (m CreateClaimRequest) Validate() error {
+	maxAmount := m.maxAmount()
+	insured := m.OrderInsurance != nil && m.OrderInsurance.InsuredAmount > 0
+	err := validation.ValidateStruct(&m,
+		validation.Field(&m.WaybillNo, validation.Required.Error("运单号不能为空")),
+		validation.Field(&m.Reason, validation.Required.Error("索赔原因不能为空"), validation.In(entity.ClaimReasonLost, entity.ClaimReasonDamaged).Error("索赔原因只能为 LOST 或 DAMAGED")),
+		validation.Field(&m.Amount,
+			validation.Required.Error("索赔金额不能为空"),
+			validation.Min(0.0001).Error("索赔金额不能小于 {{.threshold}}"),
+			validation.Max(maxAmount).Error("索赔金额不能大于保价金额或预报货值 {{.threshold}}"),
+		),
+		validation.Field(&m.DeclaredValue, validation.When(!insured, validation.Required.Error("原订单未保价时, 原订单包裹预报货值不能为空"))),
+		validation.Field(&m.Description, validation.When(m.Description.Valid, validation.Length(1, 500).Error("情况说明长度必须在 {{.min}}-{{.max}} 之间"))),
+		validation.Field(&m.Attachments, validation.When(m.Reason == entity.ClaimReasonDamaged, validation.Required.Error("破损索赔必须提供凭证附件"))),
+	)
+	return renameFields(err, map[string]string{"DeclaredValue": "declaredValue"})
+}
+
+// Create 提交索赔
+func (s claimService) Create(ctx context.Context, req CreateClaimRequest) (entity.Claim, error) {
+	if err := req.Validate(); err != nil {
+		return entity.Claim{}, invalidInput(s.locale, err)
+	}
+
+	var res struct {
+		NormalResponse
+		Data entity.Claim `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetBody(req).
+		SetResult(&res).
+		Post("/open-api/v2/claim/create")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return entity.Claim{}, err
+	}
+	return res.Data, nil
+}
+
+// Status 索赔状态查询
+// @param claimNo 索赔单号
+func (s claimService) Status(ctx context.Context, claimNo string) (entity.Claim, error) {
+	if claimNo == "" {
+		return entity.Claim{}, localizedError(s.locale, "索赔单号不能为空")
+	}
+
+	var res struct {
+		NormalResponse
+		Data entity.Claim `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetResult(&res).
+		Get(fmt.Sprintf("/open-api/v2/claim/%s", claimNo))
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return entity.Claim{}, err
+	}
+	return res.Data, nil
+}
@@ -0,0 +1,58 @@
+package gofo
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/hiscaler/gofo-go/entity"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestClaimService_Create(t *testing.T) {
+	req := CreateClaimRequest{
+		WaybillNo:      "GFUS01014625997824",
+		Reason:         entity.ClaimReasonLost,
+		Amount:         10,
+		Description:    null.StringFrom("parcel lost in transit"),
+		DeclaredValue:  12,
+		OrderInsurance: &OrderInsurance{InsuredAmount: 20},
+	}
+	claim, err := client.Services.Claims.Create(ctx, req)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	fmt.Println(claim)
+}
+
+func TestClaimService_Status(t *testing.T) {
+	_, err := client.Services.Claims.Status(ctx, "CL0001")
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestCreateClaimRequest_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		req   CreateClaimRequest
+		valid bool
+	}{
+		{"missing declared value", CreateClaimRequest{WaybillNo: "GF001", Reason: entity.ClaimReasonLost, Amount: 1000}, false},
+		{"over declared value", CreateClaimRequest{WaybillNo: "GF001", Reason: entity.ClaimReasonLost, Amount: 13, DeclaredValue: 12}, false},
+		{"within insured amount", CreateClaimRequest{WaybillNo: "GF001", Reason: entity.ClaimReasonLost, Amount: 15, OrderInsurance: &OrderInsurance{InsuredAmount: 20}}, true},
+		{"over insured amount", CreateClaimRequest{WaybillNo: "GF001", Reason: entity.ClaimReasonLost, Amount: 25, DeclaredValue: 30, OrderInsurance: &OrderInsurance{InsuredAmount: 20}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.req.Validate()
+			if (err == nil) != tt.valid {
+				t.Errorf("Expected valid %v, got %v", tt.valid, err)
+			}
+		})
+	}
+
+	if paths := errorPaths(t, tests[0].req.Validate()); !slices.Contains(paths, "declaredValue") {
+		t.Errorf("Expected declaredValue error, got %v", paths)
+	}
+}
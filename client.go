@@ -7,6 +7,7 @@ import (
 	"fmt"
 	"net/http"
 	"sort"
+	"strconv"
 	"strings"
 	"time"
 
@@ -59,9 +60,32 @@ func NewClient(ctx context.Context, cfg config.Config) *Client {
 		config:     &cfg,
 		logger:     l.l,
 		httpClient: gofoClient.httpClient,
+		cache:      newMemoryCache(),
+		locale:     LocaleZhCN,
+	}
+	if cfg.Locale != "" {
+		xService.locale = cfg.Locale
+	}
+	if cfg.Timezone != "" {
+		loc, err := time.LoadLocation(cfg.Timezone)
+		if err != nil {
+			l.Warnf("invalid timezone %s: %s", cfg.Timezone, err.Error())
+		} else {
+			xService.location = loc
+		}
 	}
 	gofoClient.Services = services{
-		Order: (orderService)(xService),
+		Order:       (orderService)(xService),
+		Rate:        (rateService)(xService),
+		Coverage:    (coverageService)(xService),
+		Address:     (addressService)(xService),
+		Pickup:      (pickupService)(xService),
+		Manifest:    (manifestService)(xService),
+		Return:      (returnService)(xService),
+		Billing:     (billingService)(xService),
+		Catalog:     (catalogService)(xService),
+		Claims:      (claimService)(xService),
+		PickupPoint: (pickupPointService)(xService),
 	}
 	return gofoClient
 }
@@ -73,77 +97,156 @@ type NormalResponse struct {
 	Data           any    `json:"data"`
 }
 
+// localizedMessage 返回指定语言的错误信息, 非中文语言优先使用 GOFO 返回的英文信息
+func (r NormalResponse) localizedMessage(locale string) string {
+	if !isChineseLocale(locale) && strings.TrimSpace(r.EnglishMessage) != "" {
+		return r.EnglishMessage
+	}
+	return r.Message
+}
+
 // errorWrap 错误包装
-func errorWrap(code int, message string) error {
+func errorWrap(locale string, code int, message string) error {
 	if code == 200 {
 		return nil
 	}
 
 	switch code {
 	case 305:
-		message = "数据不存在"
+		message = translate(locale, "数据不存在")
 	case 401:
-		message = "认证失败，无法调用接口"
+		message = translate(locale, "认证失败，无法调用接口")
 	case 404:
-		message = "接口不存在"
+		message = translate(locale, "接口不存在")
 	case 500:
 		if message == "" {
-			message = "操作失败"
+			message = translate(locale, "操作失败")
 		}
 	default:
 		message = strings.TrimSpace(message)
 		if message == "" {
-			message = "未知错误"
+			message = translate(locale, "未知错误")
 		}
 	}
 	return fmt.Errorf("%d %s", code, message)
 }
 
-func invalidInput(e error) error {
+// FieldError 单个字段的校验失败信息
+type FieldError struct {
+	Path    string         `json:"path"`             // 字段的 JSON 路径, 例如 orderConsignee.consigneeState、orderItemList[2].itemQty, 非字段错误时为空
+	Rule    string         `json:"rule"`             // 未通过的校验规则, 例如 validation_required、validation_length_out_of_range
+	Params  map[string]any `json:"params,omitempty"` // 校验规则的参数, 例如长度限制 min、max 或取值限制 threshold
+	Message string         `json:"message"`          // 错误信息
+}
+
+// ValidationError 请求参数校验错误
+type ValidationError struct {
+	Fields []FieldError `json:"fields"` // 校验失败的字段, 按字段路径排序
+}
+
+func (e *ValidationError) Error() string {
+	messages := make([]string, len(e.Fields))
+	for i, field := range e.Fields {
+		messages[i] = field.Message
+	}
+	return strings.Join(messages, "; ")
+}
+
+// collect 收集校验错误, 嵌套的 validation.Errors 会展开为多个字段
+func (e *ValidationError) collect(locale, path string, err error) {
 	var errs validation.Errors
-	if !errors.As(e, &errs) {
-		return e
+	if !errors.As(err, &errs) {
+		err = localizeError(locale, err)
+		field := FieldError{Path: path, Message: err.Error()}
+		var errObj validation.ErrorObject
+		if errors.As(err, &errObj) {
+			field.Rule = errObj.Code()
+			if len(errObj.Params()) > 0 {
+				field.Params = make(map[string]any, len(errObj.Params()))
+				for k, v := range errObj.Params() {
+					if p, ok := v.(error); ok {
+						v = p.Error()
+					}
+					field.Params[k] = v
+				}
+			}
+		}
+		e.Fields = append(e.Fields, field)
+		return
 	}
 
-	if len(errs) == 0 {
-		return nil
+	keys := make([]string, 0, len(errs))
+	for key, e1 := range errs {
+		if e1 != nil {
+			keys = append(keys, key)
+		}
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		a, errA := strconv.Atoi(keys[i])
+		b, errB := strconv.Atoi(keys[j])
+		if errA == nil && errB == nil {
+			return a < b
+		}
+		return keys[i] < keys[j]
+	})
+	for _, key := range keys {
+		e.collect(locale, fieldPath(path, key), errs[key])
 	}
+}
 
-	fields := make([]string, 0)
-	messages := make([]string, 0)
-	for field := range errs {
-		fields = append(fields, field)
+// fieldPath 拼接字段路径, 切片元素使用 [序号] 表示
+func fieldPath(parent, key string) string {
+	if _, err := strconv.Atoi(key); err == nil {
+		return parent + "[" + key + "]"
+	}
+	if parent == "" {
+		return key
 	}
-	sort.Strings(fields)
+	return parent + "." + key
+}
 
-	for _, field := range fields {
-		e1 := errs[field]
-		if e1 == nil {
-			continue
+// renameFields 替换校验错误中的字段名, 用于 json:"-" 等无法从 JSON 标签获取名称的字段
+func renameFields(err error, names map[string]string) error {
+	errs, ok := err.(validation.Errors)
+	if !ok {
+		return err
+	}
+	renamed := make(validation.Errors, len(errs))
+	for key, e := range errs {
+		if name, ok := names[key]; ok {
+			key = name
 		}
+		renamed[key] = e
+	}
+	return renamed
+}
 
-		var errObj validation.ErrorObject
-		if errors.As(e1, &errObj) {
-			e1 = errObj
-		} else {
-			var errs1 validation.Errors
-			if errors.As(e1, &errs1) {
-				e1 = invalidInput(errs1)
-				if e1 == nil {
-					continue
-				}
-			}
-		}
+// invalidInput 将校验错误转换为 *ValidationError, 已经是 *ValidationError 时原样返回
+func invalidInput(locale string, e error) error {
+	if e == nil {
+		return nil
+	}
+	var ve *ValidationError
+	if errors.As(e, &ve) {
+		return ve
+	}
+	var internalErr validation.InternalError
+	if errors.As(e, &internalErr) {
+		return e
+	}
 
-		messages = append(messages, e1.Error())
+	ve = &ValidationError{}
+	ve.collect(locale, "", e)
+	if len(ve.Fields) == 0 {
+		return nil
 	}
-	return errors.New(strings.Join(messages, "; "))
+	return ve
 }
 
-func recheckError(resp *resty.Response, e error) error {
+func recheckError(locale string, resp *resty.Response, e error) error {
 	if e != nil {
 		if errors.Is(e, http.ErrHandlerTimeout) {
-			return errorWrap(http.StatusRequestTimeout, e.Error())
+			return errorWrap(locale, http.StatusRequestTimeout, e.Error())
 		}
 		return e
 	}
@@ -156,6 +259,6 @@ func recheckError(resp *resty.Response, e error) error {
 		if err != nil {
 			return err
 		}
-		return errorWrap(normalResponse.Code, normalResponse.Message)
+		return errorWrap(locale, normalResponse.Code, normalResponse.localizedMessage(locale))
 	}
 }
@@ -3,10 +3,14 @@ package gofo
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 
+	"github.com/go-resty/resty/v2"
 	"github.com/hiscaler/gofo-go/config"
 )
 
@@ -28,3 +32,82 @@ func TestMain(m *testing.M) {
 	ctx = context.Background()
 	m.Run()
 }
+
+// newTestService 创建请求本地测试服务器的 service, 测试结束时关闭服务器
+func newTestService(t *testing.T, handler http.HandlerFunc) service {
+	srv := httptest.NewServer(handler)
+	t.Cleanup(srv.Close)
+	return service{
+		logger:     createLogger().l,
+		httpClient: resty.New().SetBaseURL(srv.URL),
+		cache:      newMemoryCache(),
+		locale:     LocaleZhCN,
+	}
+}
+
+// errorPaths 返回校验错误中的字段路径
+func errorPaths(t *testing.T, err error) []string {
+	t.Helper()
+	var ve *ValidationError
+	if !errors.As(invalidInput(LocaleEnUS, err), &ve) {
+		t.Fatalf("Expected *ValidationError, got %v", err)
+	}
+	paths := make([]string, len(ve.Fields))
+	for i, field := range ve.Fields {
+		paths[i] = field.Path
+	}
+	return paths
+}
+
+func TestInvalidInput(t *testing.T) {
+	req := CreateOrderRequest{
+		DeclaredValue: 12,
+		OrderShipper: OrderShipper{
+			ShipperName:    "test",
+			ShipperPhone:   "13000000000",
+			ShipperCountry: "CN",
+			ShipperState:   "Guangdong",
+			ShipperCity:    "Shenzhen",
+			ShipperStreet:  "test street",
+			ShipperCode:    "518000",
+		},
+		OrderConsignee: OrderConsignee{
+			ConsigneeName:    "test",
+			ConsigneeCountry: "US",
+			ConsigneeState:   "California",
+			ConsigneeCity:    "Los Angeles",
+			Address1:         "test address",
+			ConsigneeCode:    "90001",
+		},
+		OrderGoods: OrderGoods{Weight: 1, Length: 1, Height: 1, Width: 1},
+		OrderItemList: []OrderItem{
+			{ItemNameEn: "test", ItemNameZh: "测试", ItemQty: 1},
+			{ItemNameEn: "test", ItemNameZh: "测试", ItemQty: 1},
+			{ItemNameEn: "test", ItemNameZh: "测试", ItemQty: 10000},
+		},
+	}
+	err := invalidInput(LocaleEnUS, req.Validate())
+	var ve *ValidationError
+	if !errors.As(err, &ve) {
+		t.Fatalf("Expected *ValidationError, got %v", err)
+	}
+	if len(ve.Fields) != 2 {
+		t.Fatalf("Expected 2 field errors, got %v", ve.Fields)
+	}
+	state, qty := ve.Fields[0], ve.Fields[1]
+	if state.Path != "orderConsignee.consigneeState" || state.Rule != "validation_state_invalid" || state.Message != "Consignee state must be a 2-letter uppercase state code" {
+		t.Errorf("Unexpected field error %+v", state)
+	}
+	if qty.Path != "orderItemList[2].itemQty" || qty.Rule != "validation_max_less_equal_than_required" || qty.Params["threshold"] != 9999 {
+		t.Errorf("Unexpected field error %+v", qty)
+	}
+	if err.Error() != state.Message+"; "+qty.Message {
+		t.Errorf("Unexpected error message %s", err.Error())
+	}
+	if invalidInput(LocaleZhCN, nil) != nil {
+		t.Errorf("Expected nil error")
+	}
+	if invalidInput(LocaleZhCN, fmt.Errorf("wrapped: %w", err)) != err {
+		t.Errorf("Expected existing *ValidationError to be returned unchanged")
+	}
+}
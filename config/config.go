@@ -6,4 +6,6 @@ type Config struct {
 	Timeout  int    `json:"timeout"`  // HTTP 超时设定（单位：秒）
 	Account  string `json:"account"`  // 用户账号
 	Password string `json:"password"` // 用户密码
+	Timezone string `json:"timezone"` // 提交日期时间时使用的时区(IANA 名称, 例如 America/Los_Angeles), 为空时不转换
+	Locale   string `json:"locale"`   // 校验及错误信息的语言, 例如 zh-CN、en-US, 为空时使用 zh-CN
 }
@@ -0,0 +1,131 @@
+package gofo
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hiscaler/gofo-go/entity"
+)
+
+// 服务范围服务
+type coverageService service
+
+// coverageCacheTTL 服务范围查询结果缓存时间
+const coverageCacheTTL = 6 * time.Hour
+
+// CoverageQuery 服务范围查询条件
+type CoverageQuery struct {
+	Country     string `json:"country"`               // 国家
+	Postcode    string `json:"postcode"`              // 邮编
+	ProductCode string `json:"productCode,omitempty"` // 产品编码, 为空时返回所有可用产品
+}
+
+func (m CoverageQuery) Validate() error {
+	return validation.ValidateStruct(&m,
+		validation.Field(&m.Country, validation.Required.Error("国家不能为空")),
+		validation.Field(&m.Postcode, validation.Required.Error("邮编不能为空")),
+		validation.Field(&m.ProductCode, validation.When(m.ProductCode != "", validation.Length(1, 100).Error("产品编码长度必须在 {{.min}}-{{.max}} 之间"))),
+	)
+}
+
+func (m CoverageQuery) cacheKey() string {
+	return "coverage:" + coverageKey(m.Country, m.Postcode) + ":" + m.ProductCode
+}
+
+// cloneCoverage 复制服务范围, 避免调用方修改缓存中的数据
+func cloneCoverage(coverage entity.Coverage) entity.Coverage {
+	coverage.Products = slices.Clone(coverage.Products)
+	for i := range coverage.Products {
+		coverage.Products[i].ShippingTypes = slices.Clone(coverage.Products[i].ShippingTypes)
+	}
+	return coverage
+}
+
+// coverageKey 按国家及邮编匹配查询结果
+func coverageKey(country, postcode string) string {
+	return strings.ToUpper(strings.TrimSpace(country)) + ":" + strings.ToUpper(strings.ReplaceAll(postcode, " ", ""))
+}
+
+// Check 邮编服务范围查询
+// 查询结果会在本地缓存, 可在结账时按购物车频繁调用
+func (s coverageService) Check(ctx context.Context, country, postcode, productCode string) (entity.Coverage, error) {
+	coverages, err := s.CheckBatch(ctx, []CoverageQuery{{Country: country, Postcode: postcode, ProductCode: productCode}})
+	if err != nil {
+		return entity.Coverage{}, err
+	}
+	return coverages[0], nil
+}
+
+// CheckBatch 批量邮编服务范围查询, 返回结果与查询条件顺序一致
+func (s coverageService) CheckBatch(ctx context.Context, queries []CoverageQuery) ([]entity.Coverage, error) {
+	if err := validation.Validate(queries, validation.Required.Error("查询条件不能为空")); err != nil {
+		return nil, invalidInput(s.locale, err)
+	}
+
+	coverages := make([]entity.Coverage, len(queries))
+	missing := make([]int, 0, len(queries))
+	for i, query := range queries {
+		if v, ok := s.cache.Get(query.cacheKey()); ok {
+			coverages[i] = cloneCoverage(v.(entity.Coverage))
+		} else {
+			missing = append(missing, i)
+		}
+	}
+	if len(missing) == 0 {
+		return coverages, nil
+	}
+
+	// GOFO 返回的结果不包含产品编码, 不同产品编码的查询需分别请求才能匹配结果
+	groups := make(map[string][]int)
+	productCodes := make([]string, 0)
+	for _, idx := range missing {
+		code := queries[idx].ProductCode
+		if _, ok := groups[code]; !ok {
+			productCodes = append(productCodes, code)
+		}
+		groups[code] = append(groups[code], idx)
+	}
+	missed := make([]string, 0)
+	for _, code := range productCodes {
+		indexes := groups[code]
+		body := make([]CoverageQuery, len(indexes))
+		for i, idx := range indexes {
+			body[i] = queries[idx]
+		}
+		var res struct {
+			NormalResponse
+			Data []entity.Coverage `json:"data"`
+		}
+		resp, err := s.httpClient.R().
+			SetContext(ctx).
+			SetBody(body).
+			SetResult(&res).
+			Post("/open-api/v2/coverage/check")
+		if err = recheckError(s.locale, resp, err); err != nil {
+			return nil, err
+		}
+
+		results := make(map[string]entity.Coverage, len(res.Data))
+		for _, coverage := range res.Data {
+			results[coverageKey(coverage.Country, coverage.Postcode)] = coverage
+		}
+		for i, idx := range indexes {
+			coverage, ok := results[coverageKey(body[i].Country, body[i].Postcode)]
+			if !ok {
+				// GOFO 未返回的结果不能视为不可派送, 也不缓存
+				missed = append(missed, body[i].Country+" "+body[i].Postcode)
+				continue
+			}
+			coverages[idx] = coverage
+			s.cache.Set(body[i].cacheKey(), cloneCoverage(coverage), coverageCacheTTL)
+		}
+	}
+	if len(missed) > 0 {
+		return nil, errors.New(localizedMessage(s.locale, "GOFO 未返回以下邮编的服务范围: {{.postcodes}}", map[string]interface{}{"postcodes": strings.Join(missed, ", ")}))
+	}
+	return coverages, nil
+}
@@ -0,0 +1,78 @@
+package gofo
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"testing"
+
+	"github.com/hiscaler/gofo-go/entity"
+)
+
+func TestCoverageService_Check(t *testing.T) {
+	coverage, err := client.Services.Coverage.Check(ctx, "US", "90001", "")
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	fmt.Println(coverage)
+}
+
+func TestCoverageService_CheckBatch(t *testing.T) {
+	queries := []CoverageQuery{
+		{Country: "US", Postcode: "90001"},
+		{Country: "US", Postcode: "99501"},
+	}
+	coverages, err := client.Services.Coverage.CheckBatch(ctx, queries)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	fmt.Println(coverages)
+}
+
+func TestCoverageService_CheckBatchMatching(t *testing.T) {
+	svc := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		// 返回顺序与请求不一致, 且缺少 10001
+		fmt.Fprint(w, `{"code":200,"data":[{"country":"US","postcode":"99501","covered":false},{"country":"US","postcode":"90001","covered":true}]}`)
+	})
+	s := coverageService(svc)
+
+	coverages, err := s.CheckBatch(ctx, []CoverageQuery{{Country: "US", Postcode: "90001"}, {Country: "US", Postcode: "99501"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !coverages[0].Covered || coverages[0].Postcode != "90001" || coverages[1].Covered {
+		t.Errorf("Unexpected coverages %v", coverages)
+	}
+
+	if _, err = s.CheckBatch(ctx, []CoverageQuery{{Country: "US", Postcode: "10001"}}); err == nil {
+		t.Errorf("Expected missing coverage error")
+	}
+	if _, ok := s.cache.Get(CoverageQuery{Country: "US", Postcode: "10001"}.cacheKey()); ok {
+		t.Errorf("Expected missing coverage not to be cached")
+	}
+}
+
+func TestCoverageService_CheckBatchProductCodes(t *testing.T) {
+	svc := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		var body []CoverageQuery
+		if err := json.NewDecoder(r.Body).Decode(&body); err != nil || len(body) != 1 {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"code":200,"data":[{"country":"US","postcode":"90001","covered":%v}]}`, body[0].ProductCode == "A")
+	})
+	s := coverageService(svc)
+
+	coverages, err := s.CheckBatch(ctx, []CoverageQuery{{Country: "US", Postcode: "90001", ProductCode: "A"}, {Country: "US", Postcode: "90001", ProductCode: "B"}})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !coverages[0].Covered || coverages[1].Covered {
+		t.Errorf("Unexpected coverages %v", coverages)
+	}
+	if v, ok := s.cache.Get(CoverageQuery{Country: "US", Postcode: "90001", ProductCode: "A"}.cacheKey()); !ok || !v.(entity.Coverage).Covered {
+		t.Errorf("Expected product A coverage to be cached as covered")
+	}
+}
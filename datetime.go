@@ -0,0 +1,119 @@
+package gofo
+
+import (
+	"strings"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// DateTime GOFO 日期时间, 序列化格式为 yyyy-MM-dd HH:mm:ss, 零值序列化为 null
+// 提交请求时会转换为客户端配置的时区(config.Config.Timezone)
+// 反序列化得到的日期时间不包含时区, 提交请求时按客户端配置的时区解释, 未配置时区时按本地时区解释
+type DateTime struct {
+	time.Time
+	floating bool // 是否为不包含时区的日期时间(反序列化得到)
+}
+
+// NewDateTime 根据 time.Time 创建日期时间
+func NewDateTime(t time.Time) DateTime {
+	return DateTime{Time: t}
+}
+
+// ParseDateTime 解析 yyyy-MM-dd HH:mm:ss 格式的日期时间, loc 为空时使用本地时区
+func ParseDateTime(s string, loc *time.Location) (DateTime, error) {
+	if loc == nil {
+		loc = time.Local
+	}
+	t, err := time.ParseInLocation(time.DateTime, strings.TrimSpace(s), loc)
+	if err != nil {
+		return DateTime{}, validation.NewError("validation_datetime_format", "日期时间 {{.value}} 格式必须为 yyyy-MM-dd HH:mm:ss").SetParams(map[string]interface{}{"value": s})
+	}
+	return DateTime{Time: t}, nil
+}
+
+// In 转换到指定时区, loc 为空或时间为零值时原样返回
+// 不包含时区的日期时间按 loc 解释(保持年月日时分秒不变), loc 为空时按本地时区解释
+func (t DateTime) In(loc *time.Location) DateTime {
+	if t.IsZero() {
+		return t
+	}
+	if t.floating {
+		if loc == nil {
+			loc = time.Local
+		}
+		return DateTime{Time: time.Date(t.Year(), t.Month(), t.Day(), t.Hour(), t.Minute(), t.Second(), t.Nanosecond(), loc)}
+	}
+	if loc == nil {
+		return t
+	}
+	return DateTime{Time: t.Time.In(loc)}
+}
+
+func (t DateTime) String() string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(time.DateTime)
+}
+
+// orNil 零值时返回 nil, 用于配合 omitempty 不提交零值
+func (t DateTime) orNil() *DateTime {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+
+func (t DateTime) MarshalJSON() ([]byte, error) {
+	if t.IsZero() {
+		return []byte("null"), nil
+	}
+	return []byte(`"` + t.Format(time.DateTime) + `"`), nil
+}
+
+func (t *DateTime) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if s == "null" || s == `""` {
+		*t = DateTime{}
+		return nil
+	}
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return validation.NewError("validation_datetime_type", "日期时间必须为字符串")
+	}
+	v, err := ParseDateTime(s[1:len(s)-1], time.UTC)
+	if err != nil {
+		return err
+	}
+	v.floating = true
+	*t = v
+	return nil
+}
+
+// timeWindowRules 时间窗口校验规则: 开始时间及结束时间需同时提供, 结束时间晚于开始时间, 且都晚于当前时间
+// @param name 时间窗口名称, 用于错误信息
+// @param required 是否必须提供时间窗口
+func timeWindowRules(name string, start, end DateTime, required bool) (startRule, endRule validation.Rule) {
+	now := time.Now()
+	startRule = validation.By(func(interface{}) error {
+		switch {
+		case start.IsZero() && (required || !end.IsZero()):
+			return validation.NewError("validation_start_time_required", "{{.name}}开始时间不能为空").SetParams(map[string]interface{}{"name": name})
+		case !start.IsZero() && !start.After(now):
+			return validation.NewError("validation_start_time_past", "{{.name}}开始时间必须晚于当前时间").SetParams(map[string]interface{}{"name": name})
+		}
+		return nil
+	})
+	endRule = validation.By(func(interface{}) error {
+		switch {
+		case end.IsZero() && (required || !start.IsZero()):
+			return validation.NewError("validation_end_time_required", "{{.name}}结束时间不能为空").SetParams(map[string]interface{}{"name": name})
+		case !end.IsZero() && !start.IsZero() && !end.After(start.Time):
+			return validation.NewError("validation_end_time_before_start", "{{.name}}结束时间必须晚于开始时间").SetParams(map[string]interface{}{"name": name})
+		case !end.IsZero() && !end.After(now):
+			return validation.NewError("validation_end_time_past", "{{.name}}结束时间必须晚于当前时间").SetParams(map[string]interface{}{"name": name})
+		}
+		return nil
+	})
+	return
+}
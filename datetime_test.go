@@ -0,0 +1,73 @@
+package gofo
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestDateTime_JSON(t *testing.T) {
+	loc, err := time.LoadLocation("America/Los_Angeles")
+	if err != nil {
+		t.Skip(err)
+	}
+	v := NewDateTime(time.Date(2025, 6, 1, 8, 0, 0, 0, time.UTC)).In(loc)
+	b, err := json.Marshal(struct {
+		Start DateTime `json:"start"`
+		End   DateTime `json:"end"`
+	}{Start: v})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if string(b) != `{"start":"2025-06-01 01:00:00","end":null}` {
+		t.Errorf("Unexpected JSON %s", b)
+	}
+
+	var d DateTime
+	if err = json.Unmarshal([]byte(`"2025-06-01 25:00:00"`), &d); err == nil {
+		t.Errorf("Expected malformed date time error")
+	}
+
+	// 反序列化得到的日期时间按客户端时区解释
+	if err = json.Unmarshal([]byte(`"2025-06-01 01:00:00"`), &d); err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !d.In(loc).Equal(v.Time) {
+		t.Errorf("Expected %s, got %s", v.Time, d.In(loc).Time)
+	}
+
+	b, err = json.Marshal(CreateOrderRequest{})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if strings.Contains(string(b), "queryCollectStartTime") || strings.Contains(string(b), "queryCollectEndTime") {
+		t.Errorf("Expected zero collect times to be omitted, got %s", b)
+	}
+	b, err = json.Marshal(OrderListFilter{StartCreateTime: v})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.Contains(string(b), `"startCreateTime":"2025-06-01 01:00:00"`) || strings.Contains(string(b), "endCreateTime") {
+		t.Errorf("Unexpected filter JSON %s", b)
+	}
+}
+
+func TestPickupWindow_Validate(t *testing.T) {
+	now := time.Now()
+	tests := []struct {
+		name   string
+		window PickupWindow
+		valid  bool
+	}{
+		{"valid", PickupWindow{NewDateTime(now.Add(time.Hour)), NewDateTime(now.Add(2 * time.Hour))}, true},
+		{"missing end", PickupWindow{StartTime: NewDateTime(now.Add(time.Hour))}, false},
+		{"end before start", PickupWindow{NewDateTime(now.Add(2 * time.Hour)), NewDateTime(now.Add(time.Hour))}, false},
+		{"past", PickupWindow{NewDateTime(now.Add(-2 * time.Hour)), NewDateTime(now.Add(time.Hour))}, false},
+	}
+	for _, tt := range tests {
+		if err := tt.window.Validate(); (err == nil) != tt.valid {
+			t.Errorf("%s: expected valid %v, got %v", tt.name, tt.valid, err)
+		}
+	}
+}
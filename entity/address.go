@@ -0,0 +1,9 @@
+package entity
+
+// AddressCorrection 地址字段修正
+type AddressCorrection struct {
+	Field     string `json:"field"`     // 字段名称, 例如 consigneeState
+	Original  string `json:"original"`  // 原始值
+	Corrected string `json:"corrected"` // 修正后的值
+	Safe      bool   `json:"safe"`      // 是否为安全修正(仅格式调整, 不改变地址含义)
+}
@@ -0,0 +1,18 @@
+package entity
+
+// Charge 费用明细
+type Charge struct {
+	WaybillNo   string  `json:"waybillNo"`   // 运单号
+	InvoiceNo   string  `json:"invoiceNo"`   // 账单号
+	ChargeType  string  `json:"chargeType"`  // 费用类型
+	ChargeName  string  `json:"chargeName"`  // 费用名称
+	Amount      float64 `json:"amount"`      // 金额
+	Currency    string  `json:"currency"`    // 币种
+	BillingTime string  `json:"billingTime"` // 计费时间
+}
+
+// ChargeIssue 对账异常
+type ChargeIssue struct {
+	Charge Charge `json:"charge"` // 费用明细
+	Issue  string `json:"issue"`  // 异常类型
+}
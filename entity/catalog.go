@@ -0,0 +1,18 @@
+package entity
+
+// Product 产品
+type Product struct {
+	ProductCode   string   `json:"productCode"`   // 产品编码
+	ProductName   string   `json:"productName"`   // 产品名称
+	MaxWeight     float64  `json:"maxWeight"`     // 最大重量, 单位: kg
+	MaxLength     float64  `json:"maxLength"`     // 最长边, 单位: cm
+	MaxGirth      float64  `json:"maxGirth"`      // 最大长+周长, 单位: cm
+	ShippingTypes []string `json:"shippingTypes"` // 可用配送类型: HDN(送货上门), ZT(自提)
+}
+
+// EntryPort 入口岸
+type EntryPort struct {
+	Code    string `json:"code"`    // 入口岸编码
+	Name    string `json:"name"`    // 入口岸名称
+	Country string `json:"country"` // 国家
+}
@@ -0,0 +1,20 @@
+package entity
+
+// Claim 索赔
+type Claim struct {
+	ClaimNo        string  `json:"claimNo"`        // 索赔单号
+	WaybillNo      string  `json:"waybillNo"`      // 运单号
+	Reason         string  `json:"reason"`         // 索赔原因
+	Status         string  `json:"status"`         // 索赔状态
+	Amount         float64 `json:"amount"`         // 索赔金额
+	ApprovedAmount float64 `json:"approvedAmount"` // 核定赔付金额
+	Currency       string  `json:"currency"`       // 币种
+	Resolution     string  `json:"resolution"`     // 处理结果说明
+	CreateTime     string  `json:"createTime"`     // 创建时间
+	UpdateTime     string  `json:"updateTime"`     // 更新时间
+}
+
+// Closed 索赔是否已结束
+func (c Claim) Closed() bool {
+	return c.Status == ClaimStatusRejected || c.Status == ClaimStatusPaid
+}
@@ -5,3 +5,119 @@ const (
 	Test = "test" // 测试环境
 	Dev  = "dev"  // 开发环境
 )
+
+// 配送类型
+const (
+	ShippingTypeHDN = "HDN" // 送货上门
+	ShippingTypeZT  = "ZT"  // 自提
+)
+
+// 订单状态
+const (
+	OrderStatusCreated   = "CREATED"   // 已下单
+	OrderStatusCancelled = "CANCELLED" // 已取消
+)
+
+// 轨迹编码(GOFO 接口文档附件 1)
+const (
+	TrackCodeOrderCreated       = "100" // 已下单
+	TrackCodeWarehouseCheckIn   = "202" // 转运中心签入
+	TrackCodeWarehouseCheckOut  = "200" // 转运中心签出
+	TrackCodeBranchCheckIn      = "201" // 站点签入
+	TrackCodeBranchCheckOut     = "203" // 站点签出
+	TrackCodeOutForDelivery     = "208" // 快递员收件
+	TrackCodeDelivered          = "205" // 签收
+	TrackCodeDeliveryFailed     = "206" // 派送异常
+	TrackCodeReturnedToCustomer = "257" // 退件签收
+	TrackCodeLost               = "300" // 丢失
+	TrackCodeRobbed             = "301" // 被抢
+)
+
+// 拦截操作
+const (
+	InterceptActionReturnToSender = "RETURN" // 退回发件人
+	InterceptActionHoldForPickup  = "HOLD"   // 扣件待自提
+)
+
+// 拦截状态
+const (
+	InterceptStatusPending = "PENDING" // 拦截处理中
+	InterceptStatusSuccess = "SUCCESS" // 拦截成功
+	InterceptStatusFailed  = "FAILED"  // 拦截失败
+)
+
+// 地址可派送性
+const (
+	DeliverabilityDeliverable   = "DELIVERABLE"   // 可派送
+	DeliverabilityUndeliverable = "UNDELIVERABLE" // 不可派送
+	DeliverabilityUnknown       = "UNKNOWN"       // 未知
+)
+
+// 地址类型
+const (
+	AddressTypeResidential = "RESIDENTIAL" // 住宅地址
+	AddressTypeCommercial  = "COMMERCIAL"  // 商业地址
+)
+
+// 揽收预约状态
+const (
+	PickupStatusScheduled = "SCHEDULED" // 已预约
+	PickupStatusCompleted = "COMPLETED" // 已揽收
+	PickupStatusCancelled = "CANCELLED" // 已取消
+)
+
+// 签收图片类型
+const (
+	PODImageTypePhoto     = "PHOTO"     // 签收照片
+	PODImageTypeSignature = "SIGNATURE" // 签名
+)
+
+// 退货面单类型
+const (
+	ReturnLabelTypePDF = "PDF" // PDF 面单
+	ReturnLabelTypeQR  = "QR"  // 二维码, 消费者可凭二维码在网点打印面单
+)
+
+// 费用类型
+const (
+	ChargeTypeFreight     = "FREIGHT"     // 基础运费
+	ChargeTypeFuel        = "FUEL"        // 燃油附加费
+	ChargeTypeResidential = "RESIDENTIAL" // 住宅附加费
+	ChargeTypeOversize    = "OVERSIZE"    // 超尺寸附加费
+	ChargeTypeInsurance   = "INSURANCE"   // 保价费
+)
+
+// 对账异常类型
+const (
+	ChargeIssueUnknownWaybill   = "UNKNOWN_WAYBILL"   // 运单不是由我方创建
+	ChargeIssueCancelledWaybill = "CANCELLED_WAYBILL" // 运单已取消
+)
+
+// 索赔原因
+const (
+	ClaimReasonLost    = "LOST"    // 丢失
+	ClaimReasonDamaged = "DAMAGED" // 破损
+)
+
+// 索赔状态
+const (
+	ClaimStatusSubmitted = "SUBMITTED" // 已提交
+	ClaimStatusReviewing = "REVIEWING" // 审核中
+	ClaimStatusApproved  = "APPROVED"  // 已通过
+	ClaimStatusRejected  = "REJECTED"  // 已驳回
+	ClaimStatusPaid      = "PAID"      // 已赔付
+)
+
+// 面单格式
+const (
+	LabelFormatPDF  = "PDF"  // PDF
+	LabelFormatPNG  = "PNG"  // PNG 图片
+	LabelFormatZPL  = "ZPL"  // 斑马打印机指令
+	LabelFormatJPEG = "JPEG" // JPEG 图片, 仅用于识别 GOFO 返回的面单
+)
+
+// 面单尺寸
+const (
+	LabelSize4x6 = "4x6" // 4x6 英寸热敏面单
+	LabelSizeA4  = "A4"  // A4 纸
+)
@@ -0,0 +1,37 @@
+package entity
+
+// Coverage 邮编服务范围
+type Coverage struct {
+	Country  string            `json:"country"`  // 国家
+	Postcode string            `json:"postcode"` // 邮编
+	Covered  bool              `json:"covered"`  // 是否可派送
+	Remote   bool              `json:"remote"`   // 是否偏远地区
+	Products []CoverageProduct `json:"products"` // 可用产品
+}
+
+// CoverageProduct 邮编可用产品
+type CoverageProduct struct {
+	ProductCode   string   `json:"productCode"`   // 产品编码
+	ShippingTypes []string `json:"shippingTypes"` // 可用配送类型: HDN(送货上门), ZT(自提)
+}
+
+// Supports 是否支持指定产品及配送类型, shippingType 为空时仅检查产品
+func (c Coverage) Supports(productCode, shippingType string) bool {
+	if !c.Covered {
+		return false
+	}
+	for _, product := range c.Products {
+		if product.ProductCode != productCode {
+			continue
+		}
+		if shippingType == "" {
+			return true
+		}
+		for _, t := range product.ShippingTypes {
+			if t == shippingType {
+				return true
+			}
+		}
+	}
+	return false
+}
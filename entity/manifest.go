@@ -0,0 +1,9 @@
+package entity
+
+// Manifest 交接清单
+type Manifest struct {
+	ManifestNo string   `json:"manifestNo"` // 交接清单号
+	WaybillNos []string `json:"waybillNos"` // 运单号
+	CreateTime string   `json:"createTime"` // 创建时间
+	Document   []byte   `json:"-"`          // 交接清单文件(PDF)
+}
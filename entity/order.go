@@ -29,3 +29,91 @@ type TrackEvent struct {
 	ProcessProvince string `json:"processProvince"` // 轨迹发生州
 	ProcessPostCode string `json:"processPostCode"` // 轨迹发生邮编
 }
+
+// Order 订单
+type Order struct {
+	WaybillNo     string  `json:"waybillNo"`     // 运单号
+	COrderNo      string  `json:"cOrderNo"`      // 客户单号
+	ReferenceNo   string  `json:"referenceNo"`   // 参考单号
+	ProductCode   string  `json:"productCode"`   // 产品编码
+	ShippingType  string  `json:"shippingType"`  // 配送类型
+	Status        string  `json:"status"`        // 订单状态
+	DeclaredValue float64 `json:"declaredValue"` // 包裹预报货值
+	Weight        float64 `json:"weight"`        // 包裹预报重量
+	EntryPort     string  `json:"entryPort"`     // 入口岸
+	CreateTime    string  `json:"createTime"`    // 创建时间
+	UpdateTime    string  `json:"updateTime"`    // 更新时间
+	Cursor        string  `json:"-"`             // 分页游标，传入 OrderListFilter.Cursor 可从该订单之后继续查询
+}
+
+// InterceptResult 拦截结果
+type InterceptResult struct {
+	OrderNo       string `json:"orderNo"`       // 运单号
+	Action        string `json:"action"`        // 拦截操作
+	Status        string `json:"status"`        // 拦截状态
+	FailReason    string `json:"failReason"`    // 拦截失败原因
+	OperationTime string `json:"operationTime"` // 操作时间
+}
+
+// Succeeded 是否拦截成功
+func (r InterceptResult) Succeeded() bool {
+	return r.Status == InterceptStatusSuccess
+}
+
+// ProofOfDelivery 签收证明
+type ProofOfDelivery struct {
+	OrderNo      string     `json:"orderNo"`      // 运单号
+	Signer       string     `json:"signer"`       // 签收人
+	SignerType   string     `json:"signerType"`   // 签收人类型
+	Pin          string     `json:"pin"`          // 是否通过 pin 签收
+	DeliveryTime string     `json:"deliveryTime"` // 签收时间
+	Latitude     *float64   `json:"latitude"`     // 签收位置纬度
+	Longitude    *float64   `json:"longitude"`    // 签收位置经度
+	Images       []PODImage `json:"images"`       // 签收图片
+}
+
+// HasLocation 是否包含签收位置
+func (p ProofOfDelivery) HasLocation() bool {
+	return p.Latitude != nil && p.Longitude != nil
+}
+
+// PODImage 签收图片
+type PODImage struct {
+	Type       string `json:"type"`       // 图片类型: PHOTO(签收照片), SIGNATURE(签名)
+	Base64code string `json:"base64code"` // 图片 Base64 编码
+	Data       []byte `json:"-"`          // 图片数据
+}
+
+// Shipment 多件货物(一票多件)
+type Shipment struct {
+	ShipmentNo      string              `json:"shipmentNo"`      // 货件号, 即客户单号
+	MasterWaybillNo string              `json:"masterWaybillNo"` // 主运单号(第一件的运单号)
+	Pieces          []OrderCreateResult `json:"pieces"`          // 每件货物的下单结果
+}
+
+// WaybillNos 所有运单号
+func (s Shipment) WaybillNos() []string {
+	waybillNos := make([]string, len(s.Pieces))
+	for i, piece := range s.Pieces {
+		waybillNos[i] = piece.WaybillNo
+	}
+	return waybillNos
+}
+
+// Measurement GOFO 实际测量的包裹重量及尺寸
+type Measurement struct {
+	WaybillNo   string  `json:"waybillNo"`   // 运单号
+	Weight      float64 `json:"weight"`      // 实际重量
+	Length      float64 `json:"length"`      // 实际长
+	Width       float64 `json:"width"`       // 实际宽
+	Height      float64 `json:"height"`      // 实际高
+	WeightUnit  string  `json:"weightUnit"`  // 重量单位, 默认为 KG
+	LengthUnit  string  `json:"lengthUnit"`  // 尺寸单位, 默认为 CM
+	MeasureTime string  `json:"measureTime"` // 测量时间
+}
+
+// Label 面单
+type Label struct {
+	Format string // 面单实际格式, 参见 LabelFormatXXX
+	Data   []byte // 面单数据
+}
@@ -0,0 +1,13 @@
+package entity
+
+import "time"
+
+// Pickup 揽收预约
+type Pickup struct {
+	PickupId   string    `json:"pickupId"`   // 揽收预约单号
+	Status     string    `json:"status"`     // 预约状态
+	StartTime  time.Time `json:"startTime"`  // 揽收开始时间, 按客户端配置的时区解释
+	EndTime    time.Time `json:"endTime"`    // 揽收结束时间, 按客户端配置的时区解释
+	WaybillNos []string  `json:"waybillNos"` // 运单号
+	Remarks    string    `json:"remarks"`    // 备注
+}
@@ -0,0 +1,31 @@
+package entity
+
+// PickupPoint 自提点
+type PickupPoint struct {
+	PointId           string         `json:"pointId"`           // 自提点 ID
+	Name              string         `json:"name"`              // 自提点名称
+	Country           string         `json:"country"`           // 国家
+	State             string         `json:"state"`             // 州
+	City              string         `json:"city"`              // 市
+	Address           string         `json:"address"`           // 详细地址
+	Postcode          string         `json:"postcode"`          // 邮编
+	Phone             string         `json:"phone"`             // 联系电话
+	Latitude          float64        `json:"latitude"`          // 纬度
+	Longitude         float64        `json:"longitude"`         // 经度
+	Distance          float64        `json:"distance"`          // 与查询邮编的距离, 单位: 公里
+	BusinessHours     []BusinessHour `json:"businessHours"`     // 营业时间
+	Capacity          int            `json:"capacity"`          // 最大存放包裹数
+	AvailableCapacity int            `json:"availableCapacity"` // 剩余可存放包裹数
+}
+
+// Available 是否可存放包裹
+func (p PickupPoint) Available() bool {
+	return p.AvailableCapacity > 0
+}
+
+// BusinessHour 营业时间
+type BusinessHour struct {
+	Weekday   int    `json:"weekday"`   // 星期, 0 表示星期日
+	OpenTime  string `json:"openTime"`  // 开始营业时间, 格式: HH:mm
+	CloseTime string `json:"closeTime"` // 结束营业时间, 格式: HH:mm
+}
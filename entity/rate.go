@@ -0,0 +1,22 @@
+package entity
+
+// RateQuote 产品运费报价
+type RateQuote struct {
+	ProductCode    string      `json:"productCode"`    // 产品编码
+	ProductName    string      `json:"productName"`    // 产品名称
+	Currency       string      `json:"currency"`       // 币种
+	BaseFreight    float64     `json:"baseFreight"`    // 基础运费
+	Surcharges     []Surcharge `json:"surcharges"`     // 附加费
+	TotalAmount    float64     `json:"totalAmount"`    // 总费用
+	BillableWeight float64     `json:"billableWeight"` // 计费重
+	WeightUnit     string      `json:"weightUnit"`     // 计费重单位
+	MinTransitDays int         `json:"minTransitDays"` // 最短预计时效(天)
+	MaxTransitDays int         `json:"maxTransitDays"` // 最长预计时效(天)
+}
+
+// Surcharge 附加费
+type Surcharge struct {
+	Code   string  `json:"code"`   // 附加费编码
+	Name   string  `json:"name"`   // 附加费名称
+	Amount float64 `json:"amount"` // 金额
+}
@@ -0,0 +1,11 @@
+package entity
+
+// ReturnOrder 退货订单
+type ReturnOrder struct {
+	WaybillNo         string `json:"waybillNo"`         // 退货运单号
+	OriginalWaybillNo string `json:"originalWaybillNo"` // 原运单号
+	LabelType         string `json:"labelType"`         // 面单类型
+	LabelUrl          string `json:"labelUrl"`          // 面单下载地址, 可通过邮件发送给消费者
+	QrCode            string `json:"qrCode"`            // 二维码内容(面单类型为 QR 时返回)
+	VerificationPin   string `json:"verificationPin"`   // 签收 PIN 码
+}
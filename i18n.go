@@ -0,0 +1,107 @@
+package gofo
+
+import (
+	"errors"
+	"strings"
+	"sync"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// 语言
+const (
+	LocaleZhCN = "zh-CN" // 简体中文(默认)
+	LocaleEnUS = "en-US" // 英文
+)
+
+var translations = struct {
+	sync.RWMutex
+	messages map[string]map[string]string
+}{
+	messages: map[string]map[string]string{
+		normalizeLocale(LocaleEnUS): enUSMessages,
+	},
+}
+
+// normalizeLocale 标准化语言代码, 例如 en_us 转换为 en-us
+func normalizeLocale(locale string) string {
+	return strings.ToLower(strings.ReplaceAll(strings.TrimSpace(locale), "_", "-"))
+}
+
+// isChineseLocale 是否为中文语言, 为空时视为默认的简体中文
+func isChineseLocale(locale string) bool {
+	locale = normalizeLocale(locale)
+	return locale == "" || locale == "zh" || strings.HasPrefix(locale, "zh-")
+}
+
+// RegisterMessages 注册语言的消息翻译, 已存在的同名消息会被覆盖
+// 键为中文消息或消息模板(例如 "{{.field}}必须为 {{.format}}"), 值为该语言下的消息或消息模板, 未注册的消息使用英文(en-US)翻译
+// @param locale 语言代码, 例如 en-US、es-MX
+func RegisterMessages(locale string, messages map[string]string) {
+	locale = normalizeLocale(locale)
+	translations.Lock()
+	defer translations.Unlock()
+	m, ok := translations.messages[locale]
+	if !ok {
+		m = make(map[string]string, len(messages))
+		translations.messages[locale] = m
+	}
+	for k, v := range messages {
+		m[k] = v
+	}
+}
+
+// translate 翻译消息, 非中文语言没有对应翻译时使用英文(en-US)翻译, 仍没有时原样返回
+func translate(locale, message string) string {
+	if isChineseLocale(locale) || message == "" {
+		return message
+	}
+	translations.RLock()
+	defer translations.RUnlock()
+	if s, ok := translations.messages[normalizeLocale(locale)][message]; ok {
+		return s
+	}
+	if s, ok := translations.messages[normalizeLocale(LocaleEnUS)][message]; ok {
+		return s
+	}
+	return message
+}
+
+// localizeError 翻译错误信息
+// validation.ErrorObject 翻译消息模板及其中的字符串、错误参数, 其他错误按完整的错误信息翻译
+func localizeError(locale string, e error) error {
+	if e == nil || isChineseLocale(locale) {
+		return e
+	}
+
+	var errObj validation.ErrorObject
+	if errors.As(e, &errObj) {
+		params := make(map[string]interface{}, len(errObj.Params()))
+		for k, v := range errObj.Params() {
+			switch p := v.(type) {
+			case string:
+				params[k] = translate(locale, p)
+			case error:
+				params[k] = localizeError(locale, p).Error()
+			default:
+				params[k] = v
+			}
+		}
+		return errObj.SetMessage(translate(locale, errObj.Message())).SetParams(params)
+	}
+
+	if message := translate(locale, e.Error()); message != e.Error() {
+		return errors.New(message)
+	}
+	return e
+}
+
+// localizedError 创建指定语言的错误
+func localizedError(locale, message string) error {
+	return errors.New(translate(locale, message))
+}
+
+// localizedMessage 渲染指定语言的消息模板
+func localizedMessage(locale, template string, params map[string]interface{}) string {
+	return localizeError(locale, validation.NewError("", template).SetParams(params)).Error()
+}
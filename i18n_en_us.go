@@ -0,0 +1,199 @@
+package gofo
+
+// enUSMessages 英文(en-US)消息
+var enUSMessages = map[string]string{
+	// 接口错误
+	"数据不存在":       "Data not found",
+	"认证失败，无法调用接口": "Authentication failed, unable to call the API",
+	"接口不存在":       "API not found",
+	"操作失败":        "Operation failed",
+	"未知错误":        "Unknown error",
+
+	// 字段及格式名称
+	"揽收":                "Pickup",
+	"包裹预报重量":            "Forecast parcel weight",
+	"包裹的长":              "Parcel length",
+	"包裹的宽":              "Parcel width",
+	"包裹的高":              "Parcel height",
+	"发件人手机号":            "Shipper phone",
+	"发件人省/州":            "Shipper state/province",
+	"发件人邮编":             "Shipper postcode",
+	"收件人手机号":            "Consignee phone",
+	"收件人州":              "Consignee state",
+	"收件人邮编":             "Consignee postcode",
+	"5 位数字":             "5 digits",
+	"5 位数字(或 ZIP+4 格式)": "5 digits (or ZIP+4)",
+	"6 位数字":             "6 digits",
+	"2 位大写字母的州代码":       "a 2-letter uppercase state code",
+
+	// 通用校验规则
+	"{{.field}}不能小于 {{.min}} {{.unit}}":         "{{.field}} must be no less than {{.min}} {{.unit}}",
+	"{{.field}}不能大于 {{.max}} {{.unit}}":         "{{.field}} must be no greater than {{.max}} {{.unit}}",
+	"{{.field}}必须为 {{.format}}":                 "{{.field}} must be {{.format}}",
+	"{{.field}}无效: {{.reason}}":                 "{{.field}} is invalid: {{.reason}}",
+	"{{.name}}开始时间不能为空":                         "{{.name}} start time is required",
+	"{{.name}}开始时间必须晚于当前时间":                     "{{.name}} start time must be later than now",
+	"{{.name}}结束时间不能为空":                         "{{.name}} end time is required",
+	"{{.name}}结束时间必须晚于开始时间":                     "{{.name}} end time must be later than the start time",
+	"{{.name}}结束时间必须晚于当前时间":                     "{{.name}} end time must be later than now",
+	"日期时间 {{.value}} 格式必须为 yyyy-MM-dd HH:mm:ss": "Date time {{.value}} must be in the format yyyy-MM-dd HH:mm:ss",
+	"日期时间必须为字符串":                                "Date time must be a string",
+	"无效的长度单位 {{.unit}}, 只能为 CM、M 或 INCH":        "Invalid length unit {{.unit}}, must be CM, M or INCH",
+	"无效的重量单位 {{.unit}}, 只能为 KG 或 LB":            "Invalid weight unit {{.unit}}, must be KG or LB",
+	"单位 {{.unit}} 必须为标准写法 {{.canonical}}":       "Unit {{.unit}} must be written as {{.canonical}}",
+
+	// 电话号码
+	"号码不能为空": "Phone number is required",
+	"号码 {{.phone}} 包含无效字符, 只能包含数字、空格及 + - ( ) .":                      "Phone number {{.phone}} contains invalid characters, only digits, spaces and + - ( ) . are allowed",
+	"号码 {{.phone}} 的国际区号与国家 {{.country}}(+{{.callingCode}}) 不一致":      "The calling code of phone number {{.phone}} does not match country {{.country}} (+{{.callingCode}})",
+	"号码 {{.phone}} 的国内号码必须为 {{.min}} 位数字, 当前为 {{.length}} 位":          "The national number of {{.phone}} must be {{.min}} digits, got {{.length}}",
+	"号码 {{.phone}} 的国内号码必须为 {{.min}}-{{.max}} 位数字, 当前为 {{.length}} 位": "The national number of {{.phone}} must be {{.min}}-{{.max}} digits, got {{.length}}",
+	"号码 {{.phone}} 不是有效的 {{.country}} 电话号码":                           "{{.phone}} is not a valid {{.country}} phone number",
+
+	// 美国地址
+	"无法识别的美国州 {{.state}}":                             "Unknown US state {{.state}}",
+	"美国邮编 {{.zip}} 格式不正确, 必须为 5 位数字或 ZIP+4 格式":        "US ZIP code {{.zip}} is invalid, it must be 5 digits or ZIP+4",
+	"邮编 {{.zip}} 属于 {{.zipState}}, 与州 {{.state}} 不一致": "ZIP code {{.zip}} belongs to {{.zipState}}, which does not match state {{.state}}",
+
+	// 订单
+	"配送类型只能为 HDN 或 ZT":                            "Shipping type must be HDN or ZT",
+	"自提订单的自提点不能为空":                                "Pickup point is required for self-pickup orders",
+	"产品编码长度必须在 {{.min}}-{{.max}} 之间":              "Product code length must be between {{.min}} and {{.max}}",
+	"产品编码 {{.code}} 不存在":                          "Product code {{.code}} does not exist",
+	"产品 {{.code}} 不支持配送类型 {{.shippingType}}":      "Product {{.code}} does not support shipping type {{.shippingType}}",
+	"入口岸 {{.code}} 不存在":                           "Entry port {{.code}} does not exist",
+	"客户单号长度必须在 {{.min}}-{{.max}} 之间":              "Customer order number length must be between {{.min}} and {{.max}}",
+	"参考单号长度必须在 {{.min}}-{{.max}} 之间":              "Reference number length must be between {{.min}} and {{.max}}",
+	"预留字段长度必须在 {{.min}}-{{.max}} 之间":              "Reserved field length must be between {{.min}} and {{.max}}",
+	"面单 Reference 栏位内容长度必须在 {{.min}}-{{.max}} 之间": "Label reference length must be between {{.min}} and {{.max}}",
+	"消费者邮箱格式不正确":                                  "Consumer email format is invalid",
+	"订单物品信息不能为空":                                  "Order items are required",
+	"修改内容不能为空":                                    "Update content is required",
+	"修改备注长度必须在 {{.min}}-{{.max}} 之间":              "Update remarks length must be between {{.min}} and {{.max}}",
+	"订单 {{.orderNo}} 当前状态({{.status}})不允许修改":      "Order {{.orderNo}} can no longer be modified in its current status ({{.status}})",
+	"取消备注长度必须在 {{.min}}-{{.max}} 之间":              "Cancel remarks length must be between {{.min}} and {{.max}}",
+	"拦截原因不能为空":                                    "Intercept reason is required",
+	"拦截原因长度必须在 {{.min}}-{{.max}} 之间":              "Intercept reason length must be between {{.min}} and {{.max}}",
+	"拦截操作不能为空":                                    "Intercept action is required",
+	"拦截操作只能为 RETURN 或 HOLD":                       "Intercept action must be RETURN or HOLD",
+	"运单号不能为空":                                     "Waybill number is required",
+	"查询单号不能为空":                                    "Tracking numbers are required",
+	"查询条件不能为空":                                    "Query conditions are required",
+	"创建结束时间不能早于创建开始时间":                            "Created end time must not be earlier than created start time",
+	"每页数量不能小于 {{.threshold}}":                     "Page size must be no less than {{.threshold}}",
+	"每页数量不能大于 {{.threshold}}":                     "Page size must be no greater than {{.threshold}}",
+	"无效的分页游标 {{.cursor}}":                         "Invalid page cursor {{.cursor}}",
+	"面单数据为空":                                      "Label data is empty",
+	"面单数据解析失败":                                    "Failed to decode label data",
+	"签收图片数据解析失败":                                  "Failed to decode proof of delivery image",
+	"签收图片 {{.index}} 解析失败":                        "Failed to decode proof of delivery image {{.index}}",
+
+	// 多件订单
+	"货物列表不能为空":                                 "Parcels are required",
+	"货物数量必须在 {{.min}}-{{.max}} 之间":             "Number of parcels must be between {{.min}} and {{.max}}",
+	"多件订单的客户单号加上货物序号后长度不能超过 {{.max}}":          "Customer order number with the parcel sequence suffix must not exceed {{.max}} characters",
+	"多件订单的客户单号不能为空":                            "Customer order number is required for multi-piece orders",
+	"第 {{.index}} 件货物":                         "Parcel {{.index}}",
+	"货物金额 {{.amount}} 超过整票金额 {{.total}}":       "Parcel amount {{.amount}} exceeds the shipment total {{.total}}",
+	"货物预报货值合计 {{.sum}} 与整票预报货值 {{.total}} 不一致": "Sum of parcel declared values {{.sum}} does not match the shipment declared value {{.total}}",
+	"货物保价金额合计 {{.sum}} 与整票保价金额 {{.total}} 不一致": "Sum of parcel insured amounts {{.sum}} does not match the shipment insured amount {{.total}}",
+
+	// 发件人
+	"发件人姓名不能为空":                         "Shipper name is required",
+	"发件人姓名长度必须在 {{.min}}-{{.max}} 之间":   "Shipper name length must be between {{.min}} and {{.max}}",
+	"发件人手机号不能为空":                        "Shipper phone is required",
+	"发件人国家不能为空":                         "Shipper country is required",
+	"发件人省/州不能为空":                        "Shipper state/province is required",
+	"发件人省/州长度必须在 {{.min}}-{{.max}} 之间":  "Shipper state/province length must be between {{.min}} and {{.max}}",
+	"发件人市不能为空":                          "Shipper city is required",
+	"发件人城市长度必须在 {{.min}}-{{.max}} 之间":   "Shipper city length must be between {{.min}} and {{.max}}",
+	"发件人区长度必须在 {{.min}}-{{.max}} 之间":    "Shipper district length must be between {{.min}} and {{.max}}",
+	"发件人详细地址不能为空":                       "Shipper address is required",
+	"发件人详细地址长度必须在 {{.min}}-{{.max}} 之间": "Shipper address length must be between {{.min}} and {{.max}}",
+	"发件人邮编不能为空":                         "Shipper postcode is required",
+	"发件人邮箱长度必须在 {{.min}}-{{.max}} 之间":   "Shipper email length must be between {{.min}} and {{.max}}",
+
+	// 收件人
+	"收件人姓名不能为空":                         "Consignee name is required",
+	"收件人姓名长度必须在 {{.min}}-{{.max}} 之间":   "Consignee name length must be between {{.min}} and {{.max}}",
+	"收件人国家不能为空":                         "Consignee country is required",
+	"收件人州不能为空":                          "Consignee state is required",
+	"收件人州长度必须在 {{.min}}-{{.max}} 之间":    "Consignee state length must be between {{.min}} and {{.max}}",
+	"收件人市不能为空":                          "Consignee city is required",
+	"收件人市长度必须在 {{.min}}-{{.max}} 之间":    "Consignee city length must be between {{.min}} and {{.max}}",
+	"收件地址 1 不能为空":                       "Consignee address 1 is required",
+	"收件地址 1 长度必须在 {{.min}}-{{.max}} 之间": "Consignee address 1 length must be between {{.min}} and {{.max}}",
+	"收件人邮编不能为空":                         "Consignee postcode is required",
+	"收件人外门牌号不能为空":                       "Consignee exterior number is required",
+	"收件人外门牌号长度必须在 {{.min}}-{{.max}} 之间": "Consignee exterior number length must be between {{.min}} and {{.max}}",
+	"收件人内门牌号长度必须在 {{.min}}-{{.max}} 之间": "Consignee interior number length must be between {{.min}} and {{.max}}",
+	"国家不能为空":                            "Country is required",
+	"邮编不能为空":                            "Postcode is required",
+
+	// 包裹
+	"包裹预报重量不能为空":                "Forecast parcel weight is required",
+	"包裹的长不能为空":                  "Parcel length is required",
+	"包裹的宽不能为空":                  "Parcel width is required",
+	"包裹的高不能为空":                  "Parcel height is required",
+	"包裹预报货值不能为空":                "Declared value is required",
+	"包裹预报货值不能小于 {{.threshold}}": "Declared value must be no less than {{.threshold}}",
+	"包裹预报货值不能大于 {{.threshold}}": "Declared value must be no greater than {{.threshold}}",
+	"保价金额不能为空":                  "Insured amount is required",
+	"保价金额不能小于 {{.threshold}}":   "Insured amount must be no less than {{.threshold}}",
+	"保价金额不能大于 {{.threshold}}":   "Insured amount must be no greater than {{.threshold}}",
+
+	// 物品
+	"物品名称不能为空":                                       "Item name is required",
+	"物品名称长度必须在 {{.min}}-{{.max}} 之间":                 "Item name length must be between {{.min}} and {{.max}}",
+	"物品中文名称不能为空":                                     "Item Chinese name is required",
+	"物品中文名称长度必须在 {{.min}}-{{.max}} 之间":               "Item Chinese name length must be between {{.min}} and {{.max}}",
+	"物品件数不能为空":                                       "Item quantity is required",
+	"物品件数不能小于 {{.threshold}}":                        "Item quantity must be no less than {{.threshold}}",
+	"物品件数不能大于 {{.threshold}}":                        "Item quantity must be no greater than {{.threshold}}",
+	"海关编码必须为 6-10 位数字":                               "HS code must be 6-10 digits",
+	"物品单价不能小于 {{.threshold}}":                        "Item unit value must be no less than {{.threshold}}",
+	"物品单价不能大于 {{.threshold}}":                        "Item unit value must be no greater than {{.threshold}}",
+	"物品单价币种必须为 3 位大写字母":                              "Item currency must be 3 uppercase letters",
+	"物品单价必须全部填写或全部不填":                                "Item unit values must be provided for all items or none",
+	"物品总价 {{.total}} 与包裹预报货值 {{.declaredValue}} 不一致": "Items total {{.total}} does not match declared value {{.declaredValue}}",
+	"SKU 长度必须在 {{.min}}-{{.max}} 之间":                 "SKU length must be between {{.min}} and {{.max}}",
+	"原产国必须为 2 位大写字母国家代码":                             "Origin country must be a 2-letter uppercase country code",
+	"材质长度必须在 {{.min}}-{{.max}} 之间":                   "Material length must be between {{.min}} and {{.max}}",
+	"物品重量不能小于 {{.threshold}}":                        "Item weight must be no less than {{.threshold}}",
+	"物品重量不能大于 {{.threshold}}":                        "Item weight must be no greater than {{.threshold}}",
+
+	// 面单
+	"面单格式只能为 PDF、PNG 或 ZPL":                               "Label format must be PDF, PNG or ZPL",
+	"面单尺寸只能为 4x6 或 A4":                                    "Label size must be 4x6 or A4",
+	"打印分辨率只能为 203 或 300":                                  "Print resolution must be 203 or 300",
+	"旋转角度只能为 0、90、180 或 270":                              "Rotation must be 0, 90, 180 or 270",
+	"面单类型只能为 PDF 或 QR":                                    "Label type must be PDF or QR",
+	"本地不支持将 {{.from}} 面单转换为 {{.to}}":                      "Converting a {{.from}} label to {{.to}} is not supported locally",
+	"本地不支持旋转 {{.format}} 面单":                              "Rotating a {{.format}} label is not supported locally",
+	"{{.format}} 面单不是 {{.size}} 尺寸({{.dpi}} DPI), 本地无法调整": "{{.format}} label is not {{.size}} at {{.dpi}} DPI and cannot be resized locally",
+	"面单图片解析失败: {{.error}}":                                "Failed to decode label image: {{.error}}",
+
+	// 揽收预约、交接清单、退货、账单、索赔、自提点
+	"GOFO 未返回以下邮编的服务范围: {{.postcodes}}": "GOFO returned no coverage for postcodes: {{.postcodes}}",
+	"揽收预约单号不能为空":                        "Pickup number is required",
+	"查询结束时间必须晚于开始时间":                    "Query end time must be later than the start time",
+	"交接清单数据为空":                          "Manifest data is empty",
+	"交接清单数据解析失败":                        "Failed to decode manifest data",
+	"原运单号不能为空":                          "Original waybill number is required",
+	"退货原因长度必须在 {{.min}}-{{.max}} 之间":    "Return reason length must be between {{.min}} and {{.max}}",
+	"计费结束时间必须晚于开始时间":                    "Billing end time must be later than the start time",
+	"索赔单号不能为空":                          "Claim number is required",
+	"索赔原因不能为空":                          "Claim reason is required",
+	"索赔原因只能为 LOST 或 DAMAGED":            "Claim reason must be LOST or DAMAGED",
+	"原订单未保价时, 原订单包裹预报货值不能为空":            "Original declared value is required when the original order is not insured",
+	"索赔金额不能为空":                          "Claim amount is required",
+	"索赔金额不能小于 {{.threshold}}":           "Claim amount must be no less than {{.threshold}}",
+	"索赔金额不能大于保价金额或预报货值 {{.threshold}}":  "Claim amount must be no greater than the insured amount or declared value {{.threshold}}",
+	"情况说明长度必须在 {{.min}}-{{.max}} 之间":    "Description length must be between {{.min}} and {{.max}}",
+	"破损索赔必须提供凭证附件":                      "Damage claims require supporting attachments",
+	"凭证文件名不能为空":                         "Attachment file name is required",
+	"凭证文件名长度必须在 {{.min}}-{{.max}} 之间":   "Attachment file name length must be between {{.min}} and {{.max}}",
+	"凭证文件内容不能为空":                        "Attachment content is required",
+	"备注长度必须在 {{.min}}-{{.max}} 之间":      "Remarks length must be between {{.min}} and {{.max}}",
+	"搜索半径必须在 1-100 公里之间":                "Search radius must be between 1 and 100 km",
+}
@@ -0,0 +1,102 @@
+package gofo
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"strconv"
+	"strings"
+	"testing"
+	"unicode"
+)
+
+func TestInvalidInput_Locale(t *testing.T) {
+	consignee := OrderConsignee{
+		ConsigneeName:    "test",
+		ConsigneeCountry: "US",
+		ConsigneeState:   "CA",
+		ConsigneeCity:    "Los Angeles",
+		Address1:         "1 Main St",
+		ConsigneeCode:    "9000",
+		ConsigneePhone:   "555-0101",
+	}
+	err := consignee.Validate()
+	if err == nil {
+		t.Fatal("Expected validation error")
+	}
+
+	zh := invalidInput(LocaleZhCN, err).Error()
+	if !strings.Contains(zh, "收件人邮编必须为 5 位数字(或 ZIP+4 格式)") {
+		t.Errorf("Unexpected zh-CN message: %s", zh)
+	}
+	en := invalidInput(LocaleEnUS, err).Error()
+	for _, s := range []string{"Consignee postcode must be 5 digits (or ZIP+4)", "Consignee phone is invalid: The national number of 555-0101 must be 10 digits, got 7"} {
+		if !strings.Contains(en, s) {
+			t.Errorf("Expected %q in en-US message: %s", s, en)
+		}
+	}
+
+	RegisterMessages("es-MX", map[string]string{
+		"{{.field}}必须为 {{.format}}": "{{.field}} debe ser {{.format}}",
+		"收件人邮编":                     "Código postal del destinatario",
+		"5 位数字(或 ZIP+4 格式)":         "5 dígitos (o ZIP+4)",
+	})
+	es := invalidInput("es_MX", err).Error()
+	if !strings.Contains(es, "Código postal del destinatario debe ser 5 dígitos (o ZIP+4)") {
+		t.Errorf("Unexpected es-MX message: %s", es)
+	}
+	// 未注册的消息使用英文翻译, 不会出现中文
+	if !strings.Contains(es, "Consignee phone is invalid: The national number of 555-0101 must be 10 digits, got 7") {
+		t.Errorf("Expected en-US fallback in es-MX message: %s", es)
+	}
+	if strings.IndexFunc(es, func(r rune) bool { return unicode.Is(unicode.Han, r) }) != -1 {
+		t.Errorf("Unexpected Chinese in es-MX message: %s", es)
+	}
+}
+
+func TestErrorWrap_Locale(t *testing.T) {
+	r := NormalResponse{Code: 500, Message: "订单不存在", EnglishMessage: "Order not found"}
+	if err := errorWrap(LocaleEnUS, r.Code, r.localizedMessage(LocaleEnUS)); err.Error() != "500 Order not found" {
+		t.Errorf("Unexpected en-US error: %v", err)
+	}
+	if err := errorWrap(LocaleZhCN, r.Code, r.localizedMessage(LocaleZhCN)); err.Error() != "500 订单不存在" {
+		t.Errorf("Unexpected zh-CN error: %v", err)
+	}
+	if err := errorWrap(LocaleEnUS, 401, ""); err.Error() != "401 Authentication failed, unable to call the API" {
+		t.Errorf("Unexpected en-US error: %v", err)
+	}
+}
+
+// TestEnUSMessages_Coverage 检查源码中所有包含中文的字符串均已添加英文翻译
+func TestEnUSMessages_Coverage(t *testing.T) {
+	fset := token.NewFileSet()
+	pkgs, err := parser.ParseDir(fset, ".", func(fi os.FileInfo) bool {
+		return !strings.HasSuffix(fi.Name(), "_test.go") && fi.Name() != "i18n_en_us.go"
+	}, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, pkg := range pkgs {
+		for _, f := range pkg.Files {
+			tags := make(map[*ast.BasicLit]bool) // 结构体标签无需翻译
+			ast.Inspect(f, func(n ast.Node) bool {
+				if field, ok := n.(*ast.Field); ok && field.Tag != nil {
+					tags[field.Tag] = true
+				}
+				lit, ok := n.(*ast.BasicLit)
+				if !ok || lit.Kind != token.STRING || tags[lit] {
+					return true
+				}
+				s, err := strconv.Unquote(lit.Value)
+				if err != nil || strings.IndexFunc(s, func(r rune) bool { return unicode.Is(unicode.Han, r) }) == -1 {
+					return true
+				}
+				if _, ok := enUSMessages[s]; !ok {
+					t.Errorf("%s: missing en-US translation for %q", fset.Position(lit.Pos()), s)
+				}
+				return true
+			})
+		}
+	}
+}
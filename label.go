@@ -0,0 +1,247 @@
+package gofo
+
+import (
+	"bytes"
+	"cmp"
+	"encoding/hex"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hiscaler/gofo-go/entity"
+)
+
+// LabelOptions 面单选项, 零值表示原样返回 GOFO 生成的面单
+// GOFO 获取面单接口仅支持订单号参数, 格式、尺寸、分辨率及旋转均在本地处理
+// 本地仅能转换图片格式的面单, PDF 及 ZPL 面单(GOFO 默认返回 PDF)无法转换格式或旋转, 指定尺寸或分辨率时仅校验面单是否符合要求
+type LabelOptions struct {
+	Format   string `json:"format"`   // 面单格式: PDF, PNG, ZPL
+	Size     string `json:"size"`     // 面单尺寸: 4x6, A4, 默认为 4x6
+	DPI      int    `json:"dpi"`      // 打印分辨率: 203, 300, 默认为 203
+	Rotation int    `json:"rotation"` // 顺时针旋转角度: 0, 90, 180, 270
+}
+
+func (m LabelOptions) Validate() error {
+	return validation.ValidateStruct(&m,
+		validation.Field(&m.Format, validation.When(m.Format != "", validation.In(entity.LabelFormatPDF, entity.LabelFormatPNG, entity.LabelFormatZPL).Error("面单格式只能为 PDF、PNG 或 ZPL"))),
+		validation.Field(&m.Size, validation.When(m.Size != "", validation.In(entity.LabelSize4x6, entity.LabelSizeA4).Error("面单尺寸只能为 4x6 或 A4"))),
+		validation.Field(&m.DPI, validation.When(m.DPI != 0, validation.In(203, 300).Error("打印分辨率只能为 203 或 300"))),
+		validation.Field(&m.Rotation, validation.In(0, 90, 180, 270).Error("旋转角度只能为 0、90、180 或 270")),
+	)
+}
+
+// pageSize 面单尺寸(英寸)
+func (m LabelOptions) pageSize() (width, height float64) {
+	if m.Size == entity.LabelSizeA4 {
+		return 8.27, 11.69
+	}
+	return 4, 6
+}
+
+func (m LabelOptions) dpi() int {
+	if m.DPI == 0 {
+		return 203
+	}
+	return m.DPI
+}
+
+// detectLabelFormat 根据文件头识别面单格式
+func detectLabelFormat(data []byte) string {
+	switch {
+	case bytes.HasPrefix(data, []byte("%PDF")):
+		return entity.LabelFormatPDF
+	case bytes.HasPrefix(data, []byte("\x89PNG")):
+		return entity.LabelFormatPNG
+	case bytes.HasPrefix(bytes.TrimSpace(data), []byte("^XA")):
+		return entity.LabelFormatZPL
+	case bytes.HasPrefix(data, []byte("\xff\xd8")):
+		return entity.LabelFormatJPEG
+	}
+	return ""
+}
+
+var (
+	pdfMediaBoxPattern = regexp.MustCompile(`/MediaBox\s*\[\s*(-?[\d.]+)\s+(-?[\d.]+)\s+(-?[\d.]+)\s+(-?[\d.]+)\s*\]`)
+	zplWidthPattern    = regexp.MustCompile(`\^PW(\d+)`)
+	zplLengthPattern   = regexp.MustCompile(`\^LL(\d+)`)
+)
+
+// labelSizeMatched 检查 PDF 或 ZPL 面单是否为指定尺寸及分辨率, 横向或纵向均可
+// PDF 根据首页 MediaBox 判断尺寸(与分辨率无关), ZPL 根据 ^PW 及 ^LL 的点数判断尺寸及分辨率, 无法识别时视为不符合
+func labelSizeMatched(label entity.Label, opts LabelOptions) bool {
+	width, height := opts.pageSize()
+	var actualWidth, actualHeight, tolerance float64
+	switch label.Format {
+	case entity.LabelFormatPDF:
+		m := pdfMediaBoxPattern.FindSubmatch(label.Data)
+		if m == nil {
+			return false
+		}
+		var box [4]float64
+		for i := range box {
+			box[i], _ = strconv.ParseFloat(string(m[i+1]), 64)
+		}
+		actualWidth, actualHeight = math.Abs(box[2]-box[0]), math.Abs(box[3]-box[1])
+		width, height, tolerance = width*72, height*72, 2
+	case entity.LabelFormatZPL:
+		w, l := zplWidthPattern.FindSubmatch(label.Data), zplLengthPattern.FindSubmatch(label.Data)
+		if w == nil || l == nil {
+			return false
+		}
+		actualWidth, _ = strconv.ParseFloat(string(w[1]), 64)
+		actualHeight, _ = strconv.ParseFloat(string(l[1]), 64)
+		dpi := float64(opts.dpi())
+		width, height, tolerance = width*dpi, height*dpi, dpi/10
+	default:
+		return false
+	}
+	matched := func(w, h float64) bool {
+		return math.Abs(w-width) <= tolerance && math.Abs(h-height) <= tolerance
+	}
+	return matched(actualWidth, actualHeight) || matched(actualHeight, actualWidth)
+}
+
+// convertLabel 将面单转换为指定格式、尺寸及分辨率, 并按需旋转, 已满足要求时原样返回
+func convertLabel(label entity.Label, opts LabelOptions) (entity.Label, error) {
+	format := opts.Format
+	if format == "" {
+		format = label.Format
+	}
+	if format == label.Format && opts.Size == "" && opts.DPI == 0 && opts.Rotation == 0 {
+		return label, nil
+	}
+	if label.Format == entity.LabelFormatPDF || label.Format == entity.LabelFormatZPL {
+		// PDF 及 ZPL 面单本地无法再处理, 只能校验尺寸及分辨率
+		switch {
+		case format != label.Format:
+			return entity.Label{}, validation.NewError("validation_label_conversion_unsupported", "本地不支持将 {{.from}} 面单转换为 {{.to}}").SetParams(map[string]interface{}{"from": label.Format, "to": format})
+		case opts.Rotation != 0:
+			return entity.Label{}, validation.NewError("validation_label_rotation_unsupported", "本地不支持旋转 {{.format}} 面单").SetParams(map[string]interface{}{"format": label.Format})
+		case (opts.Size != "" || opts.DPI != 0) && !labelSizeMatched(label, opts):
+			return entity.Label{}, validation.NewError("validation_label_size_mismatch", "{{.format}} 面单不是 {{.size}} 尺寸({{.dpi}} DPI), 本地无法调整").SetParams(map[string]interface{}{"format": label.Format, "size": cmp.Or(opts.Size, entity.LabelSize4x6), "dpi": opts.dpi()})
+		}
+		return label, nil
+	}
+
+	img, _, err := image.Decode(bytes.NewReader(label.Data))
+	if err != nil {
+		return entity.Label{}, validation.NewError("validation_label_image_invalid", "面单图片解析失败: {{.error}}").SetParams(map[string]interface{}{"error": err})
+	}
+	width, height := opts.pageSize()
+	dpi := opts.dpi()
+	pixelWidth, pixelHeight := int(width*float64(dpi)), int(height*float64(dpi))
+	resize := format != label.Format ||
+		((opts.Size != "" || opts.DPI != 0) && (img.Bounds().Dx() != pixelWidth || img.Bounds().Dy() != pixelHeight))
+	if !resize && opts.Rotation == 0 {
+		return label, nil
+	}
+
+	// 透明像素先铺白底, 否则转换为 ZPL 时会被当作黑色打印
+	img = flattenImage(img)
+	img = rotateImage(img, opts.Rotation)
+	if resize {
+		img = fitImage(img, pixelWidth, pixelHeight)
+	}
+
+	var buf bytes.Buffer
+	switch format {
+	case entity.LabelFormatZPL:
+		buf.WriteString(imageToZPL(img))
+	case entity.LabelFormatPDF:
+		if err = png.Encode(&buf, img); err == nil {
+			var pdfImg *pdfImage
+			if pdfImg, err = newPDFImage(buf.Bytes()); err == nil {
+				buf.Reset()
+				buf.Write(buildPDF([]pdfPage{{width: width * 72, height: height * 72, image: pdfImg, bleed: true}}))
+			}
+		}
+	default:
+		// 其他图片格式统一输出为 PNG
+		format = entity.LabelFormatPNG
+		err = png.Encode(&buf, img)
+	}
+	if err != nil {
+		return entity.Label{}, err
+	}
+	return entity.Label{Format: format, Data: buf.Bytes()}, nil
+}
+
+// flattenImage 将图片绘制到白色画布上, 去除透明通道
+func flattenImage(src image.Image) image.Image {
+	b := src.Bounds()
+	dst := image.NewRGBA(image.Rect(0, 0, b.Dx(), b.Dy()))
+	draw.Draw(dst, dst.Bounds(), image.White, image.Point{}, draw.Src)
+	draw.Draw(dst, dst.Bounds(), src, b.Min, draw.Over)
+	return dst
+}
+
+// rotateImage 顺时针旋转图片
+func rotateImage(src image.Image, degree int) image.Image {
+	if degree == 0 {
+		return src
+	}
+	b := src.Bounds()
+	w, h := b.Dx(), b.Dy()
+	var dst *image.RGBA
+	if degree == 180 {
+		dst = image.NewRGBA(image.Rect(0, 0, w, h))
+	} else {
+		dst = image.NewRGBA(image.Rect(0, 0, h, w))
+	}
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			c := src.At(b.Min.X+x, b.Min.Y+y)
+			switch degree {
+			case 90:
+				dst.Set(h-1-y, x, c)
+			case 180:
+				dst.Set(w-1-x, h-1-y, c)
+			case 270:
+				dst.Set(y, w-1-x, c)
+			}
+		}
+	}
+	return dst
+}
+
+// fitImage 将图片等比缩放后居中放置在指定大小的白色画布上
+func fitImage(src image.Image, width, height int) image.Image {
+	b := src.Bounds()
+	scale := min(float64(width)/float64(b.Dx()), float64(height)/float64(b.Dy()))
+	w, h := int(float64(b.Dx())*scale), int(float64(b.Dy())*scale)
+	offsetX, offsetY := (width-w)/2, (height-h)/2
+
+	dst := image.NewRGBA(image.Rect(0, 0, width, height))
+	for i := range dst.Pix {
+		dst.Pix[i] = 0xff
+	}
+	for y := 0; y < h; y++ {
+		for x := 0; x < w; x++ {
+			dst.Set(offsetX+x, offsetY+y, src.At(b.Min.X+int(float64(x)/scale), b.Min.Y+int(float64(y)/scale)))
+		}
+	}
+	return dst
+}
+
+// imageToZPL 将图片转换为 ZPL 图形指令(^GFA)
+func imageToZPL(img image.Image) string {
+	b := img.Bounds()
+	bytesPerRow := (b.Dx() + 7) / 8
+	data := make([]byte, bytesPerRow*b.Dy())
+	for y := 0; y < b.Dy(); y++ {
+		for x := 0; x < b.Dx(); x++ {
+			gray := color.GrayModel.Convert(img.At(b.Min.X+x, b.Min.Y+y)).(color.Gray)
+			if gray.Y < 128 {
+				data[y*bytesPerRow+x/8] |= 0x80 >> (x % 8)
+			}
+		}
+	}
+	return fmt.Sprintf("^XA^FO0,0^GFA,%d,%d,%d,%s^FS^XZ", len(data), len(data), bytesPerRow, strings.ToUpper(hex.EncodeToString(data)))
+}
@@ -0,0 +1,102 @@
+package gofo
+
+import (
+	"bytes"
+	"image"
+	"image/png"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hiscaler/gofo-go/entity"
+)
+
+func TestConvertLabel(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 40, 60))
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+	label := entity.Label{Format: detectLabelFormat(buf.Bytes()), Data: buf.Bytes()}
+	if label.Format != entity.LabelFormatPNG {
+		t.Fatalf("Expected PNG label, got %s", label.Format)
+	}
+
+	zpl, err := convertLabel(label, LabelOptions{Format: entity.LabelFormatZPL, Rotation: 90})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if zpl.Format != entity.LabelFormatZPL || !strings.HasPrefix(string(zpl.Data), "^XA^FO0,0^GFA,") {
+		t.Errorf("Unexpected ZPL label %s", zpl.Format)
+	}
+
+	pdf, err := convertLabel(label, LabelOptions{Format: entity.LabelFormatPDF, Size: entity.LabelSizeA4})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if detectLabelFormat(pdf.Data) != entity.LabelFormatPDF {
+		t.Errorf("Expected PDF label")
+	}
+
+	if _, err = convertLabel(pdf, LabelOptions{Format: entity.LabelFormatZPL}); err == nil {
+		t.Errorf("Expected PDF to ZPL conversion error")
+	}
+	if _, err = convertLabel(pdf, LabelOptions{Size: entity.LabelSizeA4}); err != nil {
+		t.Errorf("Expected A4 PDF label to match, got %v", err)
+	}
+	if _, err = convertLabel(pdf, LabelOptions{Size: entity.LabelSize4x6}); err == nil {
+		t.Errorf("Expected PDF label size mismatch error")
+	}
+	if _, err = convertLabel(zpl, LabelOptions{DPI: 300}); err == nil {
+		t.Errorf("Expected ZPL label without ^PW and ^LL to be reported as mismatched")
+	}
+	zpl4x6 := entity.Label{Format: entity.LabelFormatZPL, Data: []byte("^XA^PW812^LL1218^FO0,0^FDtest^FS^XZ")}
+	if _, err = convertLabel(zpl4x6, LabelOptions{Format: entity.LabelFormatZPL, Size: entity.LabelSize4x6}); err != nil {
+		t.Errorf("Expected 4x6 ZPL label to match, got %v", err)
+	}
+
+	unknown := entity.Label{Format: detectLabelFormat([]byte("unknown")), Data: []byte("unknown")}
+	if same, err := convertLabel(unknown, LabelOptions{}); err != nil || !bytes.Equal(same.Data, unknown.Data) {
+		t.Errorf("Expected label to be returned as is without options, got %v", err)
+	}
+	if format := detectLabelFormat([]byte("\xff\xd8\xff\xe0")); format != entity.LabelFormatJPEG {
+		t.Errorf("Expected JPEG label, got %s", format)
+	}
+
+	rotated, err := convertLabel(label, LabelOptions{Format: entity.LabelFormatPNG, Rotation: 90})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cfg, _ := png.DecodeConfig(bytes.NewReader(rotated.Data)); cfg.Width != 60 || cfg.Height != 40 {
+		t.Errorf("Expected 60x40 rotated label, got %dx%d", cfg.Width, cfg.Height)
+	}
+
+	resized, err := convertLabel(label, LabelOptions{Format: entity.LabelFormatPNG, Size: entity.LabelSize4x6, DPI: 300})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if cfg, _ := png.DecodeConfig(bytes.NewReader(resized.Data)); cfg.Width != 1200 || cfg.Height != 1800 {
+		t.Errorf("Expected 1200x1800 label, got %dx%d", cfg.Width, cfg.Height)
+	}
+
+	buf.Reset()
+	if err = png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, 16, 16))); err != nil {
+		t.Fatal(err)
+	}
+	transparent := entity.Label{Format: entity.LabelFormatPNG, Data: buf.Bytes()}
+	zpl, err = convertLabel(transparent, LabelOptions{Format: entity.LabelFormatZPL})
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	fields := strings.Split(strings.TrimSuffix(string(zpl.Data), "^FS^XZ"), ",")
+	if data := fields[len(fields)-1]; strings.Trim(data, "0") != "" {
+		t.Errorf("Expected transparent pixels to print as white, got black pixels in %.32s...", data)
+	}
+}
+
+func TestLabelOptions_Validate(t *testing.T) {
+	opts := LabelOptions{Format: "GIF", Size: "A5", DPI: 600, Rotation: 45}
+	if paths := errorPaths(t, opts.Validate()); !slices.Equal(paths, []string{"dpi", "format", "rotation", "size"}) {
+		t.Errorf("Expected format, size, dpi and rotation errors, got %v", paths)
+	}
+}
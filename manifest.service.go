@@ -0,0 +1,72 @@
+package gofo
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hiscaler/gofo-go/entity"
+)
+
+// 交接清单服务
+type manifestService service
+
+// Close 日终交接, 创建交接批次并返回交接清单
+// @param orderNos GOFO 的运单号
+func (s manifestService) Close(ctx context.Context, orderNos []string) (entity.Manifest, error) {
+	err := validation.Validate(orderNos,
+		validation.Required.Error("运单号不能为空"),
+		validation.Each(validation.Required.Error("运单号不能为空")),
+	)
+	if err != nil {
+		return entity.Manifest{}, invalidInput(s.locale, err)
+	}
+
+	var res struct {
+		NormalResponse
+		Data struct {
+			entity.Manifest
+			Base64code string `json:"base64code"`
+		} `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetBody(map[string][]string{"waybillNos": orderNos}).
+		SetResult(&res).
+		Post("/open-api/v2/manifest/create")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return entity.Manifest{}, err
+	}
+
+	manifest := res.Data.Manifest
+	if res.Data.Base64code == "" {
+		return entity.Manifest{}, localizedError(s.locale, "交接清单数据为空")
+	}
+	manifest.Document, err = base64.StdEncoding.DecodeString(res.Data.Base64code)
+	if err != nil {
+		return entity.Manifest{}, fmt.Errorf("%s: %w", translate(s.locale, "交接清单数据解析失败"), err)
+	}
+	return manifest, nil
+}
+
+// PendingOrderNos 获取自上次交接以来创建的运单号, 已取消的订单不会被包含
+// @param since 上次交接时间
+func (s manifestService) PendingOrderNos(ctx context.Context, since time.Time) ([]string, error) {
+	filter := OrderListFilter{
+		StartCreateTime: NewDateTime(since),
+		EndCreateTime:   NewDateTime(time.Now()),
+	}
+	orderNos := make([]string, 0)
+	for order, err := range orderService(s).List(ctx, filter) {
+		if err != nil {
+			return nil, err
+		}
+		if order.Status == entity.OrderStatusCancelled {
+			continue
+		}
+		orderNos = append(orderNos, order.WaybillNo)
+	}
+	return orderNos, nil
+}
@@ -0,0 +1,25 @@
+package gofo
+
+import (
+	"bytes"
+	"testing"
+	"time"
+)
+
+func TestManifestService_Close(t *testing.T) {
+	orderNos, err := client.Services.Manifest.PendingOrderNos(ctx, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if len(orderNos) == 0 {
+		t.Skip("No pending orders")
+	}
+
+	manifest, err := client.Services.Manifest.Close(ctx, orderNos)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !bytes.HasPrefix(manifest.Document, []byte("%PDF-")) {
+		t.Errorf("Expected PDF manifest document")
+	}
+}
@@ -0,0 +1,71 @@
+package gofo
+
+import (
+	"cmp"
+	"math"
+	"slices"
+
+	"github.com/hiscaler/gofo-go/entity"
+)
+
+// MeasurementTolerance 重量及尺寸差异容差, 以百分比表示, 例如 5 表示 5%
+type MeasurementTolerance struct {
+	WeightPercent    float64 // 重量容差
+	DimensionPercent float64 // 尺寸容差
+}
+
+// MeasurementDiscrepancy 重量或尺寸差异
+type MeasurementDiscrepancy struct {
+	WaybillNo string  // 运单号
+	Field     string  // 差异字段: weight, length(最长边), width(次长边), height(最短边)
+	Submitted float64 // 预报值(KG/CM)
+	Measured  float64 // 实际值(KG/CM)
+	Percent   float64 // 差异百分比, 正数表示实际值大于预报值
+}
+
+// MeasurementReport 对比预报的货物规格与 GOFO 实际测量结果, 返回超出容差的差异
+// 所有值都会被转换为 KG/CM 后再进行比较, 未预报的运单及未预报或未测量的值会被忽略
+// 包裹可能以任意方向测量, 因此长宽高按从长到短排序后再逐边比较
+// @param submitted 以运单号为键的预报货物规格
+func MeasurementReport(submitted map[string]OrderGoods, measurements []entity.Measurement, tolerance MeasurementTolerance) []MeasurementDiscrepancy {
+	discrepancies := make([]MeasurementDiscrepancy, 0)
+	for _, m := range measurements {
+		goods, ok := submitted[m.WaybillNo]
+		if !ok {
+			continue
+		}
+
+		compare := func(field string, submitted, measured, tolerance float64) {
+			if submitted == 0 || measured == 0 {
+				return
+			}
+			percent := (measured - submitted) / submitted * 100
+			if math.Abs(percent) > tolerance {
+				discrepancies = append(discrepancies, MeasurementDiscrepancy{
+					WaybillNo: m.WaybillNo,
+					Field:     field,
+					Submitted: submitted,
+					Measured:  measured,
+					Percent:   math.Round(percent*100) / 100,
+				})
+			}
+		}
+		compare("weight", toKilograms(goods.Weight, goods.WeightUnit.String), toKilograms(m.Weight, m.WeightUnit), tolerance.WeightPercent)
+		submittedSides := sortedSides(toCentimeters(goods.Length, goods.LengthUnit.String), toCentimeters(goods.Width, goods.WidthUnit.String), toCentimeters(goods.Height, goods.HeightUnit.String))
+		measuredSides := sortedSides(toCentimeters(m.Length, m.LengthUnit), toCentimeters(m.Width, m.LengthUnit), toCentimeters(m.Height, m.LengthUnit))
+		for i, field := range []string{"length", "width", "height"} {
+			compare(field, submittedSides[i], measuredSides[i], tolerance.DimensionPercent)
+		}
+	}
+	return discrepancies
+}
+
+// sortedSides 将长宽高按从长到短排序, 任意一边为 0 时视为未提供尺寸, 返回全 0
+func sortedSides(length, width, height float64) []float64 {
+	if length == 0 || width == 0 || height == 0 {
+		return []float64{0, 0, 0}
+	}
+	sides := []float64{length, width, height}
+	slices.SortFunc(sides, func(a, b float64) int { return -cmp.Compare(a, b) })
+	return sides
+}
@@ -0,0 +1,29 @@
+package gofo
+
+import (
+	"testing"
+
+	"github.com/hiscaler/gofo-go/entity"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestMeasurementReport(t *testing.T) {
+	submitted := map[string]OrderGoods{
+		"GF001": {Weight: 2, Length: 10, Width: 10, Height: 10, WeightUnit: null.StringFrom("LB"), LengthUnit: null.StringFrom("INCH"), WidthUnit: null.StringFrom("INCH"), HeightUnit: null.StringFrom("INCH")},
+		"GF002": {Weight: 1, Length: 30, Width: 20, Height: 10}, // 测量方向不同
+		"GF003": {Weight: 1, Length: 30, Width: 20, Height: 10}, // 未测量
+	}
+	measurements := []entity.Measurement{
+		{WaybillNo: "GF001", Weight: 1.2, Length: 25.4, Width: 25.4, Height: 30, WeightUnit: "KG", LengthUnit: "CM"},
+		{WaybillNo: "GF999", Weight: 10},
+		{WaybillNo: "GF002", Weight: 1, Length: 20, Width: 30, Height: 10, WeightUnit: "KG", LengthUnit: "CM"},
+		{WaybillNo: "GF003", WeightUnit: "KG", LengthUnit: "CM"},
+	}
+	discrepancies := MeasurementReport(submitted, measurements, MeasurementTolerance{WeightPercent: 5, DimensionPercent: 5})
+	if len(discrepancies) != 2 {
+		t.Fatalf("Expected 2 discrepancies, got %v", discrepancies)
+	}
+	if discrepancies[0].Field != "weight" || discrepancies[1].Field != "length" || discrepancies[1].Measured != 30 {
+		t.Errorf("Unexpected discrepancies %v", discrepancies)
+	}
+}
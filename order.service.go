@@ -2,9 +2,15 @@ package gofo
 
 import (
 	"context"
+	"encoding/base64"
 	"encoding/json"
-	"errors"
 	"fmt"
+	"iter"
+	"math"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
 
 	validation "github.com/go-ozzo/ozzo-validation/v4"
 	"github.com/hiscaler/gofo-go/entity"
@@ -16,42 +22,80 @@ type orderService service
 
 // CreateOrderRequest 创建订单请求
 type CreateOrderRequest struct {
-	COrderNo              null.String     `json:"cOrderNo,omitempty"`              // 客户单号(长度 1-30)
-	ReferenceNo           null.String     `json:"referenceNo,omitempty"`           // 参考单号(长度 1-30)
-	Reference4            null.String     `json:"reference4,omitempty"`            // 预留字段(长度 1-255)，应用在面单下方，可存放 sku 信息
-	YtReference           null.String     `json:"ytReference,omitempty"`           // 面单 Reference 栏位显示内容(长度 1-30)
-	ShippingType          null.String     `json:"shippingType,omitempty"`          // 配送类型: HDN(送货上门), ZT(自提), 默认为 HDN(送货上门)
-	ProductCode           null.String     `json:"productCode,omitempty"`           // 产品编码(长度 1-100), 非全境可不传
-	DeclaredValue         float64         `json:"declaredValue"`                   // 包裹预报货值, 单位: 美金, 范围 0.0001-100.00
-	QueryCollectStartTime null.String     `json:"queryCollectStartTime,omitempty"` // 揽收开始时间, 格式: yyyy-MM-dd HH:mm:ss
-	QueryCollectEndTime   null.String     `json:"queryCollectEndTime,omitempty"`   // 揽收结束时间, 格式: yyyy-MM-dd HH:mm:ss
-	OrderShipper          OrderShipper    `json:"orderShipper"`                    // 寄件信息
-	OrderConsignee        OrderConsignee  `json:"orderConsignee"`                  // 收件信息
-	OrderGoods            OrderGoods      `json:"orderGoods"`                      // 订单货物规格
-	OrderItemList         []OrderItem     `json:"orderItemList"`                   // 订单物品信息
-	EntryPort             string          `json:"entryPort"`                       // 入口岸
-	OrderInsurance        *OrderInsurance `json:"orderInsurance,omitempty"`        // 订单保价
+	COrderNo              null.String     `json:"cOrderNo,omitempty"`       // 客户单号(长度 1-30)
+	ReferenceNo           null.String     `json:"referenceNo,omitempty"`    // 参考单号(长度 1-30)
+	Reference4            null.String     `json:"reference4,omitempty"`     // 预留字段(长度 1-255)，应用在面单下方，可存放 sku 信息
+	YtReference           null.String     `json:"ytReference,omitempty"`    // 面单 Reference 栏位显示内容(长度 1-30)
+	ShippingType          null.String     `json:"shippingType,omitempty"`   // 配送类型: HDN(送货上门), ZT(自提), 默认为 HDN(送货上门)
+	PickupPointId         null.String     `json:"pickupPointId,omitempty"`  // 自提点 ID, 配送类型为 ZT(自提)时必填
+	ProductCode           null.String     `json:"productCode,omitempty"`    // 产品编码(长度 1-100), 非全境可不传
+	DeclaredValue         float64         `json:"declaredValue"`            // 包裹预报货值, 单位: 美金, 范围 0.0001-100.00
+	QueryCollectStartTime DateTime        `json:"queryCollectStartTime"`    // 揽收开始时间, 序列化格式: yyyy-MM-dd HH:mm:ss, 零值时不提交
+	QueryCollectEndTime   DateTime        `json:"queryCollectEndTime"`      // 揽收结束时间, 需晚于揽收开始时间, 零值时不提交
+	OrderShipper          OrderShipper    `json:"orderShipper"`             // 寄件信息
+	OrderConsignee        OrderConsignee  `json:"orderConsignee"`           // 收件信息
+	OrderGoods            OrderGoods      `json:"orderGoods"`               // 订单货物规格
+	OrderItemList         []OrderItem     `json:"orderItemList"`            // 订单物品信息
+	EntryPort             string          `json:"entryPort"`                // 入口岸
+	OrderInsurance        *OrderInsurance `json:"orderInsurance,omitempty"` // 订单保价
+	catalog               *Catalog        // 产品及入口岸目录, 设置后校验产品编码及入口岸是否存在
+}
+
+// WithCatalog 设置产品及入口岸目录, 校验时将拒绝目录中不存在的产品编码及入口岸
+func (m CreateOrderRequest) WithCatalog(catalog Catalog) CreateOrderRequest {
+	m.catalog = &catalog
+	return m
+}
+
+// WithPickupPoint 将订单设置为自提(ZT)并指定自提点
+func (m CreateOrderRequest) WithPickupPoint(pointId string) CreateOrderRequest {
+	m.ShippingType = null.StringFrom(entity.ShippingTypeZT)
+	m.PickupPointId = null.StringFrom(pointId)
+	return m
+}
+
+// MarshalJSON 揽收时间为零值时不提交
+func (m CreateOrderRequest) MarshalJSON() ([]byte, error) {
+	type alias CreateOrderRequest
+	return json.Marshal(struct {
+		alias
+		QueryCollectStartTime *DateTime `json:"queryCollectStartTime,omitempty"`
+		QueryCollectEndTime   *DateTime `json:"queryCollectEndTime,omitempty"`
+	}{alias(m), m.QueryCollectStartTime.orNil(), m.QueryCollectEndTime.orNil()})
 }
 
 func (m CreateOrderRequest) Validate() error {
+	collectStartRule, collectEndRule := timeWindowRules("揽收", m.QueryCollectStartTime, m.QueryCollectEndTime, false)
 	return validation.ValidateStruct(&m,
 		validation.Field(&m.COrderNo, validation.When(m.COrderNo.Valid, validation.Length(1, 30).Error("客户单号长度必须在 {{.min}}-{{.max}} 之间"))),
 		validation.Field(&m.ReferenceNo, validation.When(m.ReferenceNo.Valid, validation.Length(1, 30).Error("参考单号长度必须在 {{.min}}-{{.max}} 之间"))),
 		validation.Field(&m.Reference4, validation.When(m.Reference4.Valid, validation.Length(1, 255).Error("预留字段长度必须在 {{.min}}-{{.max}} 之间"))),
 		validation.Field(&m.YtReference, validation.When(m.YtReference.Valid, validation.Length(1, 30).Error("面单 Reference 栏位内容长度必须在 {{.min}}-{{.max}} 之间"))),
-		validation.Field(&m.ProductCode, validation.When(m.ProductCode.Valid, validation.Length(1, 100).Error("产品编码长度必须在 {{.min}}-{{.max}} 之间"))),
+		validation.Field(&m.ShippingType, validation.When(m.ShippingType.Valid, validation.In(entity.ShippingTypeHDN, entity.ShippingTypeZT).Error("配送类型只能为 HDN 或 ZT"))),
+		validation.Field(&m.PickupPointId, validation.When(m.ShippingType.String == entity.ShippingTypeZT, validation.Required.Error("自提订单的自提点不能为空"))),
+		validation.Field(&m.ProductCode,
+			validation.When(m.ProductCode.Valid, validation.Length(1, 100).Error("产品编码长度必须在 {{.min}}-{{.max}} 之间")),
+			validation.When(m.ProductCode.Valid && m.catalog != nil, validation.By(func(value interface{}) error {
+				return m.catalog.checkProduct(m.ProductCode.String, m.ShippingType.String)
+			})),
+		),
 		validation.Field(&m.DeclaredValue, validation.Required.Error("包裹预报货值不能为空"), validation.Min(0.0001).Error("包裹预报货值不能小于 {{.threshold}}"), validation.Max(100.00).Error("包裹预报货值不能大于 {{.threshold}}")),
+		validation.Field(&m.EntryPort, validation.When(m.EntryPort != "" && m.catalog != nil, validation.By(func(value interface{}) error {
+			return m.catalog.checkEntryPort(m.EntryPort)
+		}))),
+		validation.Field(&m.QueryCollectStartTime, collectStartRule),
+		validation.Field(&m.QueryCollectEndTime, collectEndRule),
 		validation.Field(&m.OrderShipper),
 		validation.Field(&m.OrderConsignee),
 		validation.Field(&m.OrderGoods),
-		validation.Field(&m.OrderItemList, validation.Required.Error("订单物品信息不能为空")),
+		validation.Field(&m.OrderItemList, validation.Required.Error("订单物品信息不能为空"), validItemsValue(m.OrderItemList, m.DeclaredValue)),
 	)
 }
 
 // OrderShipper 发件人信息
 type OrderShipper struct {
 	ShipperName    string      `json:"shipperName"`            // 发件人-姓名, 长度为 1-50
-	ShipperPhone   string      `json:"shipperPhone"`           // 发件人-手机号, 长度为 10-14
+	ShipperPhone   string      `json:"shipperPhone"`           // 发件人-手机号, 按 ShipperCountry 校验, 提交时标准化为不含国际区号的国内号码
 	ShipperCountry string      `json:"shipperCountry"`         // 发件人-国家
 	ShipperState   string      `json:"shipperState"`           // 发件人-省/州, 长度 1-35
 	ShipperCity    string      `json:"shipperCity"`            // 发件人-市, 长度 1-50
@@ -62,15 +106,16 @@ type OrderShipper struct {
 }
 
 func (m OrderShipper) Validate() error {
+	rule, _ := LookupAddressRule(m.ShipperCountry)
 	return validation.ValidateStruct(&m,
 		validation.Field(&m.ShipperName, validation.Required.Error("发件人姓名不能为空"), validation.Length(1, 50).Error("发件人姓名长度必须在 {{.min}}-{{.max}} 之间")),
-		validation.Field(&m.ShipperPhone, validation.Required.Error("发件人手机号不能为空"), validation.Length(10, 14).Error("发件人手机号长度必须在 {{.min}}-{{.max}} 之间")),
+		validation.Field(&m.ShipperPhone, validation.Required.Error("发件人手机号不能为空"), validPhone("发件人手机号", m.ShipperCountry)),
 		validation.Field(&m.ShipperCountry, validation.Required.Error("发件人国家不能为空")),
-		validation.Field(&m.ShipperState, validation.Required.Error("发件人省/州不能为空"), validation.Length(1, 35).Error("发件人省/州长度必须在 {{.min}}-{{.max}} 之间")),
+		validation.Field(&m.ShipperState, validation.Required.Error("发件人省/州不能为空"), validation.Length(1, 35).Error("发件人省/州长度必须在 {{.min}}-{{.max}} 之间"), rule.stateRule("发件人省/州")),
 		validation.Field(&m.ShipperCity, validation.Required.Error("发件人市不能为空"), validation.Length(1, 50).Error("发件人城市长度必须在 {{.min}}-{{.max}} 之间")),
 		validation.Field(&m.ShipperArea, validation.When(m.ShipperArea.Valid, validation.Length(1, 50).Error("发件人区长度必须在 {{.min}}-{{.max}} 之间"))),
 		validation.Field(&m.ShipperStreet, validation.Required.Error("发件人详细地址不能为空"), validation.Length(1, 100).Error("发件人详细地址长度必须在 {{.min}}-{{.max}} 之间")),
-		validation.Field(&m.ShipperCode, validation.Required.Error("发件人邮编不能为空")),
+		validation.Field(&m.ShipperCode, validation.Required.Error("发件人邮编不能为空"), rule.postcodeRule("发件人邮编")),
 		validation.Field(&m.ShipperEmail, validation.When(m.ShipperEmail.Valid, validation.Length(1, 100).Error("发件人邮箱长度必须在 {{.min}}-{{.max}} 之间"))),
 	)
 }
@@ -78,7 +123,7 @@ func (m OrderShipper) Validate() error {
 // OrderConsignee 收件人信息
 type OrderConsignee struct {
 	ConsigneeName    string      `json:"consigneeName"`             // 收件人-姓名, 长度为 1-100
-	ConsigneePhone   string      `json:"consigneePhone"`            // 收件人-手机号, 长度为 10-14
+	ConsigneePhone   string      `json:"consigneePhone"`            // 收件人-手机号, 按 ConsigneeCountry 校验, 提交时标准化为不含国际区号的国内号码
 	ConsigneeCountry string      `json:"consigneeCountry"`          // 收件人-国家
 	ConsigneeState   string      `json:"consigneeState"`            // 收件人-州, 长度 1-35
 	ConsigneeCity    string      `json:"consigneeCity"`             // 收件人-市, 长度 1-50
@@ -86,7 +131,7 @@ type OrderConsignee struct {
 	Address1         string      `json:"address1"`                  // 收件地址 1, 长度 1-255
 	Address2         null.String `json:"address2,omitempty"`        // 收件地址 2, 长度 1-255
 	Address3         null.String `json:"address3,omitempty"`        // 收件地址 3, 长度 1-255
-	ConsigneeCode    string      `json:"consigneeCode"`             // 收件人-邮编, 必须为 5-6 位数字
+	ConsigneeCode    string      `json:"consigneeCode"`             // 收件人-邮编, 格式按国家地址规则校验(参见 RegisterAddressRule)
 	ConsigneeNumIn   null.String `json:"consigneeNumIn,omitempty"`  // 收件人-内门牌号, 长度 1-20
 	ConsigneeNumExt  null.String `json:"consigneeNumExt,omitempty"` // 收件人-外门牌号, 长度 1-20
 	Remarks          null.String `json:"remarks,omitempty"`         // 收件地址的附加信息, 长度 1-120
@@ -94,47 +139,131 @@ type OrderConsignee struct {
 }
 
 func (m OrderConsignee) Validate() error {
+	rule, _ := LookupAddressRule(m.ConsigneeCountry)
 	return validation.ValidateStruct(&m,
 		validation.Field(&m.ConsigneeName, validation.Required.Error("收件人姓名不能为空"), validation.Length(1, 100).Error("收件人姓名长度必须在 {{.min}}-{{.max}} 之间")),
-		//validation.Field(&m.ConsigneePhone, validation.Required.Error("收件人手机号不能为空"), validation.Length(10, 14).Error("收件人手机号长度必须在 {{.min}}-{{.max}} 之间")),
+		validation.Field(&m.ConsigneePhone, validPhone("收件人手机号", m.ConsigneeCountry)),
 		validation.Field(&m.ConsigneeCountry, validation.Required.Error("收件人国家不能为空")),
-		validation.Field(&m.ConsigneeState, validation.Required.Error("收件人州不能为空"), validation.Length(1, 35).Error("收件人州长度必须在 {{.min}}-{{.max}} 之间")),
+		validation.Field(&m.ConsigneeState, validation.Required.Error("收件人州不能为空"), validation.Length(1, 35).Error("收件人州长度必须在 {{.min}}-{{.max}} 之间"), rule.stateRule("收件人州")),
 		validation.Field(&m.ConsigneeCity, validation.Required.Error("收件人市不能为空"), validation.Length(1, 50).Error("收件人市长度必须在 {{.min}}-{{.max}} 之间")),
 		validation.Field(&m.Address1, validation.Required.Error("收件地址 1 不能为空"), validation.Length(1, 255).Error("收件地址 1 长度必须在 {{.min}}-{{.max}} 之间")),
-		//validation.Field(&m.ConsigneeCode, validation.Required.Error("收件人邮编不能为空"), validation.Length(5, 6).Error("收件人邮编必须为 {{.min}}-{{.max}} 位数字")),
+		validation.Field(&m.ConsigneeCode, validation.Required.Error("收件人邮编不能为空"), rule.postcodeRule("收件人邮编")),
+		validation.Field(&m.ConsigneeNumIn, validation.When(m.ConsigneeNumIn.Valid, validation.Length(1, 20).Error("收件人内门牌号长度必须在 {{.min}}-{{.max}} 之间"))),
+		validation.Field(&m.ConsigneeNumExt,
+			validation.When(rule.NumExtRequired, validation.Required.Error("收件人外门牌号不能为空")),
+			validation.When(m.ConsigneeNumExt.Valid, validation.Length(1, 20).Error("收件人外门牌号长度必须在 {{.min}}-{{.max}} 之间")),
+		),
 	)
 }
 
 // OrderGoods 订单货物规格
+// 重量及尺寸范围按 GOFO 标准单位(KG/CM)校验, 其他单位会先换算后再校验
 type OrderGoods struct {
-	Weight     float64     `json:"weight"`               // 包裹预报重量, 单位: kg, 值为 0.001-99.00
-	Length     float64     `json:"length"`               // 包裹的长, 单位: cm, 范围 1 到 999
-	Height     float64     `json:"height"`               // 包裹的高, 单位: cm, 范围 1 到 999
-	Width      float64     `json:"width"`                // 包裹的宽, 单位: cm, 范围 1 到 999
+	Weight     float64     `json:"weight"`               // 包裹预报重量, 单位由 WeightUnit 指定, 换算为 kg 后值为 0.001-99.00
+	Length     float64     `json:"length"`               // 包裹的长, 单位由 LengthUnit 指定, 换算为 cm 后范围 0.01 到 999
+	Height     float64     `json:"height"`               // 包裹的高, 单位由 HeightUnit 指定, 换算为 cm 后范围 0.01 到 999
+	Width      float64     `json:"width"`                // 包裹的宽, 单位由 WidthUnit 指定, 换算为 cm 后范围 0.01 到 999
 	LengthUnit null.String `json:"lengthUnit,omitempty"` // 包裹长度的计量单位, 例如厘米(CM)、米(M)、英寸(INCH)。默认为 CM
 	WidthUnit  null.String `json:"widthUnit,omitempty"`  // 包裹宽度的计量单位, 默认为 CM
 	HeightUnit null.String `json:"heightUnit,omitempty"` // 包裹高度的计量单位, 默认为 CM
 	WeightUnit null.String `json:"weightUnit,omitempty"` // 包裹预报重量的计量单位, 例如千克(KG)、磅(LB)。默认为 KG
 }
 
+// GoodsFromCentimeters 根据公制规格(厘米/千克)创建货物规格
+func GoodsFromCentimeters(length, width, height, weightKg float64) OrderGoods {
+	return OrderGoods{
+		Length:     length,
+		Width:      width,
+		Height:     height,
+		Weight:     weightKg,
+		LengthUnit: null.StringFrom(string(LengthUnitCM)),
+		WidthUnit:  null.StringFrom(string(LengthUnitCM)),
+		HeightUnit: null.StringFrom(string(LengthUnitCM)),
+		WeightUnit: null.StringFrom(string(WeightUnitKG)),
+	}.Normalize()
+}
+
+// GoodsFromInches 根据英制规格(英寸/磅)创建货物规格, 返回值已换算为厘米/千克
+func GoodsFromInches(length, width, height, weightLb float64) OrderGoods {
+	return OrderGoods{
+		Length:     length,
+		Width:      width,
+		Height:     height,
+		Weight:     weightLb,
+		LengthUnit: null.StringFrom(string(LengthUnitInch)),
+		WidthUnit:  null.StringFrom(string(LengthUnitInch)),
+		HeightUnit: null.StringFrom(string(LengthUnitInch)),
+		WeightUnit: null.StringFrom(string(WeightUnitLB)),
+	}.Normalize()
+}
+
+// Normalize 将重量及尺寸换算为 GOFO 标准单位(KG/CM)
+// 无效的单位及对应的值保持不变, 由校验返回错误
+func (m OrderGoods) Normalize() OrderGoods {
+	if unit, err := ParseWeightUnit(m.WeightUnit.String); err == nil {
+		m.Weight, m.WeightUnit = round(unit.ToKilograms(m.Weight), 3), null.StringFrom(string(WeightUnitKG))
+	}
+	normalizeLength := func(value *float64, unit *null.String) {
+		if u, err := ParseLengthUnit(unit.String); err == nil {
+			*value, *unit = round(u.ToCentimeters(*value), 2), null.StringFrom(string(LengthUnitCM))
+		}
+	}
+	normalizeLength(&m.Length, &m.LengthUnit)
+	normalizeLength(&m.Width, &m.WidthUnit)
+	normalizeLength(&m.Height, &m.HeightUnit)
+	return m
+}
+
+// convertedRange 换算为标准单位后检查取值范围
+func convertedRange(name, unit string, convert func(float64) float64, min, max float64) validation.Rule {
+	return validation.By(func(value interface{}) error {
+		v := convert(value.(float64))
+		if v < min {
+			return validation.NewError("validation_min_greater_equal_than_required", "{{.field}}不能小于 {{.min}} {{.unit}}").SetParams(map[string]interface{}{"field": name, "min": min, "max": max, "unit": unit})
+		}
+		if v > max {
+			return validation.NewError("validation_max_less_equal_than_required", "{{.field}}不能大于 {{.max}} {{.unit}}").SetParams(map[string]interface{}{"field": name, "min": min, "max": max, "unit": unit})
+		}
+		return nil
+	})
+}
+
 func (m OrderGoods) Validate() error {
+	// 单位会原样提交给 GOFO, 因此必须为大写且不含空格的标准写法, 其他写法可先调用 Normalize 转换
+	weightUnit, weightUnitErr := strictUnit(ParseWeightUnit, m.WeightUnit.String)
+	lengthUnit, lengthUnitErr := strictUnit(ParseLengthUnit, m.LengthUnit.String)
+	widthUnit, widthUnitErr := strictUnit(ParseLengthUnit, m.WidthUnit.String)
+	heightUnit, heightUnitErr := strictUnit(ParseLengthUnit, m.HeightUnit.String)
 	return validation.ValidateStruct(&m,
-		validation.Field(&m.Weight, validation.Required.Error("包裹预报重量不能为空"), validation.Min(0.001).Error("包裹预报重量不能小于 {{.threshold}}"), validation.Max(99.00).Error("包裹预报重量不能大于 {{.threshold}}")),
-		validation.Field(&m.Length, validation.Required.Error("包裹的长不能为空"), validation.Min(0.01).Error("包裹的长不能小于 {{.threshold}}"), validation.Max(999.0).Error("包裹的长不能大于 {{.threshold}}")),
-		validation.Field(&m.Height, validation.Required.Error("包裹的高不能为空"), validation.Min(0.01).Error("包裹的高不能小于 {{.threshold}}"), validation.Max(999.0).Error("包裹的高不能大于 {{.threshold}}")),
-		validation.Field(&m.Width, validation.Required.Error("包裹的宽不能为空"), validation.Min(0.01).Error("包裹的宽不能小于 {{.threshold}}"), validation.Max(999.0).Error("包裹的宽不能大于 {{.threshold}}")),
-		validation.Field(&m.LengthUnit, validation.When(m.LengthUnit.Valid, validation.In("CM", "M", "INCH").Error("包裹长度的计量单位只能为 CM、M 或 INCH"))),
-		validation.Field(&m.WidthUnit, validation.When(m.WidthUnit.Valid, validation.In("CM", "M", "INCH").Error("包裹宽度的计量单位只能为 CM、M 或 INCH"))),
-		validation.Field(&m.HeightUnit, validation.When(m.HeightUnit.Valid, validation.In("CM", "M", "INCH").Error("包裹高度的计量单位只能为 CM、M 或 INCH"))),
-		validation.Field(&m.WeightUnit, validation.When(m.WeightUnit.Valid, validation.In("KG", "LB").Error("包裹预报重量的计量单位只能为 KG 或 LB"))),
+		validation.Field(&m.Weight, validation.Required.Error("包裹预报重量不能为空"), validation.When(weightUnitErr == nil, convertedRange("包裹预报重量", "KG", weightUnit.ToKilograms, 0.001, 99.00))),
+		validation.Field(&m.Length, validation.Required.Error("包裹的长不能为空"), validation.When(lengthUnitErr == nil, convertedRange("包裹的长", "CM", lengthUnit.ToCentimeters, 0.01, 999.0))),
+		validation.Field(&m.Height, validation.Required.Error("包裹的高不能为空"), validation.When(heightUnitErr == nil, convertedRange("包裹的高", "CM", heightUnit.ToCentimeters, 0.01, 999.0))),
+		validation.Field(&m.Width, validation.Required.Error("包裹的宽不能为空"), validation.When(widthUnitErr == nil, convertedRange("包裹的宽", "CM", widthUnit.ToCentimeters, 0.01, 999.0))),
+		validation.Field(&m.LengthUnit, validation.By(func(interface{}) error { return lengthUnitErr })),
+		validation.Field(&m.WidthUnit, validation.By(func(interface{}) error { return widthUnitErr })),
+		validation.Field(&m.HeightUnit, validation.By(func(interface{}) error { return heightUnitErr })),
+		validation.Field(&m.WeightUnit, validation.By(func(interface{}) error { return weightUnitErr })),
 	)
 }
 
+var (
+	hsCodeRegexp      = regexp.MustCompile(`^\d{6,10}$`)
+	currencyRegexp    = regexp.MustCompile(`^[A-Z]{3}$`)
+	countryCodeRegexp = regexp.MustCompile(`^[A-Z]{2}$`)
+)
+
 // OrderItem 订单物品信息
 type OrderItem struct {
-	ItemNameEn string `json:"itemNameEn"` // 物品名称, 长度 1-128
-	ItemNameZh string `json:"itemNameZh"` // 物品中文名称, 长度 1-60
-	ItemQty    int    `json:"itemQty"`    // 物品件数, 范围 1 到 9999
+	ItemNameEn    string      `json:"itemNameEn"`              // 物品名称, 长度 1-128
+	ItemNameZh    string      `json:"itemNameZh"`              // 物品中文名称, 长度 1-60
+	ItemQty       int         `json:"itemQty"`                 // 物品件数, 范围 1 到 9999
+	HsCode        null.String `json:"hsCode,omitempty"`        // 海关编码, 6-10 位数字
+	UnitValue     null.Float  `json:"unitValue,omitempty"`     // 物品单价, 范围 0.0001-10000
+	Currency      null.String `json:"currency,omitempty"`      // 物品单价币种, 3 位字母币种代码, 默认为 USD
+	Sku           null.String `json:"sku,omitempty"`           // SKU, 长度 1-100
+	OriginCountry null.String `json:"originCountry,omitempty"` // 原产国二字码
+	Material      null.String `json:"material,omitempty"`      // 材质, 长度 1-100
+	Weight        null.Float  `json:"weight,omitempty"`        // 物品单件重量, 单位: kg, 范围 0.001-99
 }
 
 func (m OrderItem) Validate() error {
@@ -145,9 +274,70 @@ func (m OrderItem) Validate() error {
 			validation.Required.Error("物品件数不能为空"),
 			validation.Min(1).Error("物品件数不能小于 {{.threshold}}"),
 			validation.Max(9999).Error("物品件数不能大于 {{.threshold}}")),
+		validation.Field(&m.HsCode, validation.When(m.HsCode.Valid, validation.Match(hsCodeRegexp).Error("海关编码必须为 6-10 位数字"))),
+		validation.Field(&m.UnitValue, validation.When(m.UnitValue.Valid, validation.Min(0.0001).Error("物品单价不能小于 {{.threshold}}"), validation.Max(10000.0).Error("物品单价不能大于 {{.threshold}}"))),
+		validation.Field(&m.Currency, validation.When(m.Currency.Valid, validation.Match(currencyRegexp).Error("物品单价币种必须为 3 位大写字母"))),
+		validation.Field(&m.Sku, validation.When(m.Sku.Valid, validation.Length(1, 100).Error("SKU 长度必须在 {{.min}}-{{.max}} 之间"))),
+		validation.Field(&m.OriginCountry, validation.When(m.OriginCountry.Valid, validation.Match(countryCodeRegexp).Error("原产国必须为 2 位大写字母国家代码"))),
+		validation.Field(&m.Material, validation.When(m.Material.Valid, validation.Length(1, 100).Error("材质长度必须在 {{.min}}-{{.max}} 之间"))),
+		validation.Field(&m.Weight, validation.When(m.Weight.Valid, validation.Min(0.001).Error("物品重量不能小于 {{.threshold}}"), validation.Max(99.0).Error("物品重量不能大于 {{.threshold}}"))),
 	)
 }
 
+// currency 物品单价币种, 默认为 USD
+func (m OrderItem) currency() string {
+	if m.Currency.Valid && m.Currency.String != "" {
+		return m.Currency.String
+	}
+	return "USD"
+}
+
+// itemsValue 以美金计的物品总价, 存在未填写单价或非美金单价的物品时返回 false
+func itemsValue(items []OrderItem) (float64, bool) {
+	total := 0.0
+	for _, item := range items {
+		if !item.UnitValue.Valid || item.currency() != "USD" {
+			return 0, false
+		}
+		total += item.UnitValue.Float64 * float64(item.ItemQty)
+	}
+	return round(total, 4), len(items) > 0
+}
+
+// validItemsValue 物品单价需全部填写或全部不填, 全部以美金填写时总价需与包裹预报货值一致
+func validItemsValue(items []OrderItem, declaredValue float64) validation.Rule {
+	return validation.By(func(interface{}) error {
+		valued := 0
+		for _, item := range items {
+			if item.UnitValue.Valid {
+				valued++
+			}
+		}
+		if valued != 0 && valued != len(items) {
+			return validation.NewError("validation_items_value_incomplete", "物品单价必须全部填写或全部不填")
+		}
+		if total, ok := itemsValue(items); ok && math.Abs(total-declaredValue) > 0.01 {
+			return validation.NewError("validation_items_value_mismatch", "物品总价 {{.total}} 与包裹预报货值 {{.declaredValue}} 不一致").SetParams(map[string]interface{}{"total": total, "declaredValue": declaredValue})
+		}
+		return nil
+	})
+}
+
+// skuSummary 生成 SKU 汇总信息, 例如 "SKU-A*2;SKU-B*1", 超出长度时截断并以 ... 结尾
+func skuSummary(items []OrderItem, maxLength int) string {
+	parts := make([]string, 0, len(items))
+	for _, item := range items {
+		if item.Sku.Valid && item.Sku.String != "" {
+			parts = append(parts, fmt.Sprintf("%s*%d", item.Sku.String, item.ItemQty))
+		}
+	}
+	summary := strings.Join(parts, ";")
+	if runes := []rune(summary); len(runes) > maxLength {
+		summary = string(runes[:maxLength-3]) + "..."
+	}
+	return summary
+}
+
 // OrderInsurance 订单保价
 type OrderInsurance struct {
 	InsuredAmount float64 `json:"insuredAmount"` // 保价金额(是否保价为是时必填), 范围 0.0001-10000
@@ -159,10 +349,97 @@ func (m OrderInsurance) Validate() error {
 	)
 }
 
+// CreateOrderOption 创建订单选项
+type CreateOrderOption func(*createOrderOptions)
+
+type createOrderOptions struct {
+	correctAddress  bool                      // 提交前是否自动修正收件地址
+	normalizePhone  bool                      // 提交前是否标准化发件人及收件人手机号
+	addressOptions  []AddressValidationOption // 修正收件地址时的地址校验选项
+	validateCatalog bool                      // 提交前是否校验产品编码及入口岸
+}
+
+// WithAddressCorrection 提交前校验收件地址, 并自动应用其中的安全修正
+// @param opts 地址校验选项, 例如 WithOfflineFallback()
+func WithAddressCorrection(opts ...AddressValidationOption) CreateOrderOption {
+	return func(o *createOrderOptions) {
+		o.correctAddress = true
+		o.addressOptions = opts
+	}
+}
+
+// WithPhoneNormalization 提交前将发件人及收件人手机号标准化为 GOFO 接受的格式(不包含国际区号的国内号码)
+// 无法解析的号码保持不变, 由校验返回错误
+//
+// Deprecated: 手机号提交时总会标准化, 无需再使用此选项
+func WithPhoneNormalization() CreateOrderOption {
+	return func(o *createOrderOptions) {
+		o.normalizePhone = true
+	}
+}
+
+// WithCatalogValidation 提交前根据产品及入口岸目录校验产品编码及入口岸是否存在
+func WithCatalogValidation() CreateOrderOption {
+	return func(o *createOrderOptions) {
+		o.validateCatalog = true
+	}
+}
+
 // Create 创建订单
-func (s orderService) Create(ctx context.Context, req CreateOrderRequest) (entity.OrderCreateResult, error) {
+func (s orderService) Create(ctx context.Context, req CreateOrderRequest, opts ...CreateOrderOption) (entity.OrderCreateResult, error) {
+	req, err := s.prepare(ctx, req, opts...)
+	if err != nil {
+		return entity.OrderCreateResult{}, err
+	}
+	return s.create(ctx, req)
+}
+
+// prepare 按创建订单选项预处理订单(加载产品目录、修正收件地址、标准化手机号), 并转换时区
+func (s orderService) prepare(ctx context.Context, req CreateOrderRequest, opts ...CreateOrderOption) (CreateOrderRequest, error) {
+	options := createOrderOptions{}
+	for _, opt := range opts {
+		opt(&options)
+	}
+	if options.validateCatalog && req.catalog == nil {
+		catalog, err := catalogService(s).Load(ctx)
+		if err != nil {
+			return req, err
+		}
+		req = req.WithCatalog(catalog)
+	}
+	if options.correctAddress {
+		// 先修正地址再校验, 以便标准化后的州代码、邮编等能够通过国家地址规则
+		result, err := addressService(s).Validate(ctx, req.OrderConsignee, options.addressOptions...)
+		if err != nil {
+			return req, err
+		}
+		req.OrderConsignee = result.ApplySafeCorrections(req.OrderConsignee)
+	}
+	if options.normalizePhone {
+		req.OrderShipper, _ = req.OrderShipper.NormalizePhone()
+		req.OrderConsignee, _ = req.OrderConsignee.NormalizePhone()
+	}
+	// 先转换时区再校验, 以便不包含时区的揽收时间按客户端时区与当前时间比较
+	req.QueryCollectStartTime = req.QueryCollectStartTime.In(s.location)
+	req.QueryCollectEndTime = req.QueryCollectEndTime.In(s.location)
+	return req, nil
+}
+
+// withSkuSummary 预留字段为空时填充 SKU 汇总信息
+func (m CreateOrderRequest) withSkuSummary() CreateOrderRequest {
+	if !m.Reference4.Valid {
+		if summary := skuSummary(m.OrderItemList, 255); summary != "" {
+			m.Reference4 = null.StringFrom(summary)
+		}
+	}
+	return m
+}
+
+// create 校验并提交已预处理的订单
+func (s orderService) create(ctx context.Context, req CreateOrderRequest) (entity.OrderCreateResult, error) {
+	req = req.withSkuSummary()
 	if err := req.Validate(); err != nil {
-		return entity.OrderCreateResult{}, invalidInput(err)
+		return entity.OrderCreateResult{}, invalidInput(s.locale, err)
 	}
 
 	var res struct {
@@ -173,7 +450,7 @@ func (s orderService) Create(ctx context.Context, req CreateOrderRequest) (entit
 		SetContext(ctx).
 		SetBody(req).
 		Post("/open-api/v2/order/create")
-	if err = recheckError(resp, err); err != nil {
+	if err = recheckError(s.locale, resp, err); err != nil {
 		return entity.OrderCreateResult{}, err
 	}
 
@@ -182,7 +459,7 @@ func (s orderService) Create(ctx context.Context, req CreateOrderRequest) (entit
 		return entity.OrderCreateResult{}, err
 	}
 	if r.Code == 500 {
-		return entity.OrderCreateResult{}, errorWrap(r.Code, r.Message)
+		return entity.OrderCreateResult{}, errorWrap(s.locale, r.Code, r.localizedMessage(s.locale))
 	}
 
 	if err = json.Unmarshal(resp.Body(), &res); err != nil {
@@ -191,6 +468,291 @@ func (s orderService) Create(ctx context.Context, req CreateOrderRequest) (entit
 	return res.Data, nil
 }
 
+// Parcel 多件订单中的单件货物
+type Parcel struct {
+	OrderGoods    OrderGoods  `json:"orderGoods"`    // 货物规格
+	OrderItemList []OrderItem `json:"orderItemList"` // 物品信息
+	DeclaredValue null.Float  `json:"-"`             // 本件货物的预报货值, 为空时使用物品总价或按件均分订单模板的预报货值
+	InsuredAmount null.Float  `json:"-"`             // 本件货物的保价金额, 订单模板保价时有效, 为空时按件均分订单模板的保价金额
+}
+
+func (m Parcel) Validate() error {
+	return validation.ValidateStruct(&m,
+		validation.Field(&m.OrderGoods),
+		validation.Field(&m.OrderItemList, validation.Required.Error("订单物品信息不能为空")),
+	)
+}
+
+// MultiPieceOrderRequest 多件订单(一票多件)请求
+// 每件货物将以 Order 为模板创建一个关联订单, 客户单号为 "{COrderNo}-{序号}", 参考单号为 COrderNo
+// Order 中的预报货值及保价金额为整票金额, 会拆分到每件货物
+type MultiPieceOrderRequest struct {
+	Order   CreateOrderRequest `json:"order"`   // 订单模板, 其中 OrderGoods 和 OrderItemList 会被每件货物的信息替换
+	Parcels []Parcel           `json:"parcels"` // 货物列表
+}
+
+func (m MultiPieceOrderRequest) Validate() error {
+	err := validation.ValidateStruct(&m,
+		validation.Field(&m.Order, validation.By(func(value interface{}) error {
+			if !m.Order.COrderNo.Valid || m.Order.COrderNo.String == "" {
+				return validation.NewError("validation_multi_piece_order_no_required", "多件订单的客户单号不能为空")
+			}
+			return nil
+		}), validation.Skip),
+		validation.Field(&m.Parcels, validation.Required.Error("货物列表不能为空"), validation.Length(1, 99).Error("货物数量必须在 {{.min}}-{{.max}} 之间")),
+	)
+	errs, ok := err.(validation.Errors)
+	if err != nil && !ok {
+		return err
+	}
+	if errs == nil {
+		errs = validation.Errors{}
+	}
+
+	declaredValues, insuredAmounts := m.parcelAmounts()
+	parcelErrs, orderErr := validAmounts(m.Order.DeclaredValue, declaredValues, "declaredValue", validation.NewError("validation_multi_piece_declared_value_mismatch", "货物预报货值合计 {{.sum}} 与整票预报货值 {{.total}} 不一致"))
+	mergeErrors(errs, validation.Errors{"parcels": parcelErrs})
+	if orderErr != nil {
+		mergeErrors(errs, validation.Errors{"order": validation.Errors{"declaredValue": orderErr}})
+	}
+	if m.Order.OrderInsurance != nil {
+		parcelErrs, orderErr = validAmounts(m.Order.OrderInsurance.InsuredAmount, insuredAmounts, "insuredAmount", validation.NewError("validation_multi_piece_insured_amount_mismatch", "货物保价金额合计 {{.sum}} 与整票保价金额 {{.total}} 不一致"))
+		mergeErrors(errs, validation.Errors{"parcels": parcelErrs})
+		if orderErr != nil {
+			mergeErrors(errs, validation.Errors{"order": validation.Errors{"orderInsurance": validation.Errors{"insuredAmount": orderErr}}})
+		}
+	}
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// validAmounts 校验每件货物指定的金额不超过整票金额, 全部指定时合计需与整票金额一致
+// 返回以货物序号为键的单件错误, 以及合计不一致时的 mismatch 错误
+func validAmounts(total float64, amounts []null.Float, field string, mismatch validation.Error) (validation.Errors, error) {
+	parcelErrs := validation.Errors{}
+	sum := 0.0
+	all := len(amounts) > 0
+	for i, amount := range amounts {
+		if !amount.Valid {
+			all = false
+			continue
+		}
+		sum += amount.Float64
+		if round(amount.Float64, 4) > round(total, 4) {
+			parcelErrs[strconv.Itoa(i)] = validation.Errors{
+				field: validation.NewError("validation_multi_piece_parcel_amount_exceeded", "货物金额 {{.amount}} 超过整票金额 {{.total}}").SetParams(map[string]interface{}{"amount": amount.Float64, "total": total}),
+			}
+		}
+	}
+	sum = round(sum, 4)
+	if sum > round(total, 4) || (all && sum != round(total, 4)) {
+		return parcelErrs, mismatch.SetParams(map[string]interface{}{"sum": sum, "total": total})
+	}
+	return parcelErrs, nil
+}
+
+// mergeErrors 将 src 合并到 dst, 同一字段均为 validation.Errors 时递归合并, 否则保留 dst 中的错误
+func mergeErrors(dst, src validation.Errors) {
+	for key, err := range src {
+		if errs, ok := err.(validation.Errors); ok && len(errs) == 0 {
+			continue
+		}
+		existing, ok := dst[key]
+		if !ok || existing == nil {
+			dst[key] = err
+			continue
+		}
+		dstErrs, ok1 := existing.(validation.Errors)
+		srcErrs, ok2 := err.(validation.Errors)
+		if ok1 && ok2 {
+			mergeErrors(dstErrs, srcErrs)
+		}
+	}
+}
+
+// parcelAmounts 每件货物的预报货值及保价金额, 未指定预报货值时使用物品总价
+func (m MultiPieceOrderRequest) parcelAmounts() (declaredValues, insuredAmounts []null.Float) {
+	declaredValues = make([]null.Float, len(m.Parcels))
+	insuredAmounts = make([]null.Float, len(m.Parcels))
+	for i, parcel := range m.Parcels {
+		declaredValues[i] = parcel.DeclaredValue
+		if !parcel.DeclaredValue.Valid {
+			if value, ok := itemsValue(parcel.OrderItemList); ok {
+				declaredValues[i] = null.FloatFrom(value)
+			}
+		}
+		insuredAmounts[i] = parcel.InsuredAmount
+	}
+	return declaredValues, insuredAmounts
+}
+
+// splitAmount 拆分整票金额, 已指定金额的货物保持不变, 其余货物均分剩余金额, 尾差计入最后一件
+func splitAmount(total float64, amounts []null.Float) []float64 {
+	result := make([]float64, len(amounts))
+	rest := total
+	unassigned := make([]int, 0, len(amounts))
+	for i, amount := range amounts {
+		if amount.Valid {
+			result[i] = amount.Float64
+			rest -= amount.Float64
+		} else {
+			unassigned = append(unassigned, i)
+		}
+	}
+	if len(unassigned) == 0 || rest <= 0 {
+		return result
+	}
+	share := math.Floor(rest/float64(len(unassigned))*10000) / 10000
+	for _, i := range unassigned {
+		result[i] = share
+	}
+	result[unassigned[len(unassigned)-1]] = round(rest-share*float64(len(unassigned)-1), 4)
+	return result
+}
+
+// pieces 生成每件货物的创建订单请求
+func (m MultiPieceOrderRequest) pieces() []CreateOrderRequest {
+	declaredValues, insuredAmounts := m.parcelAmounts()
+	declaredValueShares := splitAmount(m.Order.DeclaredValue, declaredValues)
+	var insuredAmountShares []float64
+	if m.Order.OrderInsurance != nil {
+		insuredAmountShares = splitAmount(m.Order.OrderInsurance.InsuredAmount, insuredAmounts)
+	}
+
+	pieces := make([]CreateOrderRequest, len(m.Parcels))
+	for i, parcel := range m.Parcels {
+		req := m.Order
+		req.COrderNo = null.StringFrom(fmt.Sprintf("%s-%d", m.Order.COrderNo.String, i+1))
+		if !req.ReferenceNo.Valid {
+			req.ReferenceNo = m.Order.COrderNo
+		}
+		if !req.YtReference.Valid {
+			req.YtReference = null.StringFrom(fmt.Sprintf("%d/%d", i+1, len(m.Parcels)))
+		}
+		req.OrderGoods = parcel.OrderGoods
+		req.OrderItemList = parcel.OrderItemList
+		req.DeclaredValue = declaredValueShares[i]
+		if insuredAmountShares != nil {
+			req.OrderInsurance = &OrderInsurance{InsuredAmount: insuredAmountShares[i]}
+		}
+		pieces[i] = req
+	}
+	return pieces
+}
+
+// pieceFields 每件货物各自的订单字段, 对应 Parcel 中的字段, 其余字段均来自订单模板
+var pieceFields = map[string]string{
+	"orderGoods":     "orderGoods",
+	"orderItemList":  "orderItemList",
+	"declaredValue":  "declaredValue",
+	"orderInsurance": "insuredAmount",
+}
+
+// validatePieces 校验每件货物的订单
+// 货物字段的错误报告在 parcels[i] 下, 订单模板字段的错误只报告一次, 位于 order 下
+// 模板字段以最后一件货物为准, 其客户单号加上的序号最长
+func validatePieces(pieces []CreateOrderRequest) error {
+	orderErrs := validation.Errors{}
+	parcelErrs := validation.Errors{}
+	for i, piece := range pieces {
+		err := piece.withSkuSummary().Validate()
+		if err == nil {
+			continue
+		}
+		errs, ok := err.(validation.Errors)
+		if !ok {
+			return err
+		}
+		pieceErrs := validation.Errors{}
+		for key, e := range errs {
+			if field, ok := pieceFields[key]; ok {
+				// 保价错误位于 orderInsurance.insuredAmount, 对应货物的 insuredAmount
+				if insuranceErrs, ok := e.(validation.Errors); ok && field == "insuredAmount" && insuranceErrs["insuredAmount"] != nil {
+					e = insuranceErrs["insuredAmount"]
+				}
+				pieceErrs[field] = e
+				continue
+			}
+			if i == len(pieces)-1 {
+				if key == "cOrderNo" {
+					e = validation.NewError("validation_multi_piece_order_no_too_long", "多件订单的客户单号加上货物序号后长度不能超过 {{.max}}").SetParams(map[string]interface{}{"max": 30})
+				}
+				orderErrs[key] = e
+			}
+		}
+		if len(pieceErrs) > 0 {
+			parcelErrs[strconv.Itoa(i)] = pieceErrs
+		}
+	}
+	errs := validation.Errors{}
+	if len(orderErrs) > 0 {
+		errs["order"] = orderErrs
+	}
+	if len(parcelErrs) > 0 {
+		errs["parcels"] = parcelErrs
+	}
+	return errs.Filter()
+}
+
+// multiPieceRollbackTimeout 多件订单创建失败时取消已创建订单的超时时间
+const multiPieceRollbackTimeout = 30 * time.Second
+
+// CreateMultiPiece 创建多件订单
+// 每件货物分别创建关联订单并作为一个货件返回, 任一件创建失败时会取消已创建的订单
+func (s orderService) CreateMultiPiece(ctx context.Context, req MultiPieceOrderRequest, opts ...CreateOrderOption) (entity.Shipment, error) {
+	if err := req.Validate(); err != nil {
+		return entity.Shipment{}, invalidInput(s.locale, err)
+	}
+
+	// 订单选项只需对订单模板处理一次, 处理后再生成并校验每件货物的订单
+	order, err := s.prepare(ctx, req.Order, opts...)
+	if err != nil {
+		return entity.Shipment{}, err
+	}
+	req.Order = order
+	pieces := req.pieces()
+	if err = validatePieces(pieces); err != nil {
+		return entity.Shipment{}, invalidInput(s.locale, err)
+	}
+
+	shipment := entity.Shipment{
+		ShipmentNo: req.Order.COrderNo.String,
+		Pieces:     make([]entity.OrderCreateResult, 0, len(pieces)),
+	}
+	for i, piece := range pieces {
+		result, err := s.create(ctx, piece)
+		if err != nil {
+			// 调用方的 ctx 可能已取消, 回滚使用独立的超时控制, 避免遗留已创建的订单
+			rollbackCtx, cancel := context.WithTimeout(context.WithoutCancel(ctx), multiPieceRollbackTimeout)
+			for _, created := range shipment.Pieces {
+				if _, e := s.Cancel(rollbackCtx, CancelOrderRequest{OrderNo: created.WaybillNo, Remarks: null.StringFrom("multi-piece rollback")}); e != nil {
+					s.logger.Error("cancel multi-piece order failed", "waybillNo", created.WaybillNo, "error", e)
+				}
+			}
+			cancel()
+			return entity.Shipment{}, fmt.Errorf("%s: %w", localizedMessage(s.locale, "第 {{.index}} 件货物", map[string]interface{}{"index": i + 1}), err)
+		}
+		shipment.Pieces = append(shipment.Pieces, result)
+	}
+	shipment.MasterWaybillNo = shipment.Pieces[0].WaybillNo
+	return shipment, nil
+}
+
+// ShipmentTracks 多件货物轨迹查询, 返回以运单号为键的轨迹
+func (s orderService) ShipmentTracks(ctx context.Context, shipment entity.Shipment) (map[string][]entity.TrackEvent, error) {
+	tracks := make(map[string][]entity.TrackEvent, len(shipment.Pieces))
+	for _, waybillNo := range shipment.WaybillNos() {
+		events, err := s.Tracks(ctx, waybillNo)
+		if err != nil {
+			return nil, err
+		}
+		tracks[waybillNo] = events
+	}
+	return tracks, nil
+}
+
 // CancelOrderRequest 取消订单请求
 type CancelOrderRequest struct {
 	OrderNo string      `json:"orderNo"`           // GOFO 的运单号
@@ -207,7 +769,7 @@ func (m CancelOrderRequest) Validate() error {
 // Cancel 取消订单
 func (s orderService) Cancel(ctx context.Context, req CancelOrderRequest) (bool, error) {
 	if err := req.Validate(); err != nil {
-		return false, invalidInput(err)
+		return false, invalidInput(s.locale, err)
 	}
 
 	var res NormalResponse
@@ -216,15 +778,190 @@ func (s orderService) Cancel(ctx context.Context, req CancelOrderRequest) (bool,
 		SetBody(req).
 		SetResult(&res).
 		Post("/open-api/v2/order/cancel")
-	if err = recheckError(resp, err); err != nil {
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// OrderPatch 订单修改内容, 仅非空字段会被修改
+type OrderPatch struct {
+	ReferenceNo    null.String     `json:"referenceNo,omitempty"`    // 参考单号(长度 1-30)
+	Reference4     null.String     `json:"reference4,omitempty"`     // 预留字段(长度 1-255)
+	YtReference    null.String     `json:"ytReference,omitempty"`    // 面单 Reference 栏位显示内容(长度 1-30)
+	Remarks        null.String     `json:"remarks,omitempty"`        // 修改备注, 长度 1-100
+	OrderConsignee *OrderConsignee `json:"orderConsignee,omitempty"` // 收件信息
+	OrderGoods     *OrderGoods     `json:"orderGoods,omitempty"`     // 订单货物规格
+}
+
+func (m OrderPatch) Validate() error {
+	if !m.ReferenceNo.Valid && !m.Reference4.Valid && !m.YtReference.Valid && !m.Remarks.Valid && m.OrderConsignee == nil && m.OrderGoods == nil {
+		return validation.NewError("validation_order_patch_empty", "修改内容不能为空")
+	}
+	return validation.ValidateStruct(&m,
+		validation.Field(&m.ReferenceNo, validation.When(m.ReferenceNo.Valid, validation.Length(1, 30).Error("参考单号长度必须在 {{.min}}-{{.max}} 之间"))),
+		validation.Field(&m.Reference4, validation.When(m.Reference4.Valid, validation.Length(1, 255).Error("预留字段长度必须在 {{.min}}-{{.max}} 之间"))),
+		validation.Field(&m.YtReference, validation.When(m.YtReference.Valid, validation.Length(1, 30).Error("面单 Reference 栏位内容长度必须在 {{.min}}-{{.max}} 之间"))),
+		validation.Field(&m.Remarks, validation.When(m.Remarks.Valid, validation.Length(1, 100).Error("修改备注长度必须在 {{.min}}-{{.max}} 之间"))),
+		validation.Field(&m.OrderConsignee),
+		validation.Field(&m.OrderGoods),
+	)
+}
+
+// OrderNotEditableError 订单已过可修改阶段(已产生揽收、转运等轨迹)
+type OrderNotEditableError struct {
+	OrderNo string // 运单号
+	Status  string // 订单最新的轨迹编码
+	Code    int    // GOFO 返回的错误码
+	Message string // GOFO 返回的错误信息
+	locale  string // 错误信息的语言
+}
+
+func (e *OrderNotEditableError) Error() string {
+	return fmt.Sprintf("%d %s: %s", e.Code, localizedMessage(e.locale, "订单 {{.orderNo}} 当前状态({{.status}})不允许修改", map[string]interface{}{"orderNo": e.OrderNo, "status": e.Status}), e.Message)
+}
+
+// latestTrackEvent 返回操作时间最晚的轨迹事件
+func latestTrackEvent(events []entity.TrackEvent) (entity.TrackEvent, bool) {
+	if len(events) == 0 {
+		return entity.TrackEvent{}, false
+	}
+	latest := events[0]
+	for _, event := range events[1:] {
+		if event.OperationTime > latest.OperationTime {
+			latest = event
+		}
+	}
+	return latest, true
+}
+
+// pickedUpTrackCodes 订单已揽收(不可再修改)的轨迹编码
+var pickedUpTrackCodes = map[string]bool{
+	entity.TrackCodeWarehouseCheckIn:   true,
+	entity.TrackCodeWarehouseCheckOut:  true,
+	entity.TrackCodeBranchCheckIn:      true,
+	entity.TrackCodeBranchCheckOut:     true,
+	entity.TrackCodeOutForDelivery:     true,
+	entity.TrackCodeDelivered:          true,
+	entity.TrackCodeDeliveryFailed:     true,
+	entity.TrackCodeReturnedToCustomer: true,
+	entity.TrackCodeLost:               true,
+	entity.TrackCodeRobbed:             true,
+}
+
+// Update 修改订单
+// 仅在订单揽收前可修改, 修改失败且订单最新轨迹为已知的揽收后轨迹编码时返回 *OrderNotEditableError, 否则原样返回 GOFO 的错误
+// @param orderNo GOFO 的运单号
+func (s orderService) Update(ctx context.Context, orderNo string, patch OrderPatch) (bool, error) {
+	if orderNo == "" {
+		return false, localizedError(s.locale, "运单号不能为空")
+	}
+	if err := patch.Validate(); err != nil {
+		return false, invalidInput(s.locale, err)
+	}
+
+	var res NormalResponse
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetBody(struct {
+			OrderNo string `json:"orderNo"`
+			OrderPatch
+		}{orderNo, patch}).
+		SetResult(&res).
+		Post("/open-api/v2/order/update")
+	if err == nil && !resp.IsError() && res.Code != 200 {
+		// GOFO 未提供表示订单不可修改的错误码, 修改失败时根据订单轨迹判断是否已过揽收前阶段
+		if events, e := s.Tracks(ctx, orderNo); e == nil {
+			if latest, ok := latestTrackEvent(events); ok && pickedUpTrackCodes[latest.OperationMove] {
+				return false, &OrderNotEditableError{OrderNo: orderNo, Status: latest.OperationMove, Code: res.Code, Message: res.localizedMessage(s.locale), locale: s.locale}
+			}
+		}
+	}
+	if err = recheckError(s.locale, resp, err); err != nil {
 		return false, err
 	}
 	return true, nil
 }
 
+// InterceptOrderRequest 拦截订单请求
+type InterceptOrderRequest struct {
+	OrderNo string `json:"orderNo"` // GOFO 的运单号
+	Reason  string `json:"reason"`  // 拦截原因, 长度 1-100
+	Action  string `json:"action"`  // 拦截操作: RETURN(退回发件人), HOLD(扣件待自提)
+}
+
+func (m InterceptOrderRequest) Validate() error {
+	return validation.ValidateStruct(&m,
+		validation.Field(&m.OrderNo, validation.Required.Error("运单号不能为空")),
+		validation.Field(&m.Reason, validation.Required.Error("拦截原因不能为空"), validation.Length(1, 100).Error("拦截原因长度必须在 {{.min}}-{{.max}} 之间")),
+		validation.Field(&m.Action, validation.Required.Error("拦截操作不能为空"), validation.In(entity.InterceptActionReturnToSender, entity.InterceptActionHoldForPickup).Error("拦截操作只能为 RETURN 或 HOLD")),
+	)
+}
+
+// Intercept 拦截订单
+// @param orderNo GOFO 的运单号
+// @param reason 拦截原因
+// @param action 拦截操作, 参见 entity.InterceptActionXXX
+func (s orderService) Intercept(ctx context.Context, orderNo, reason, action string) (entity.InterceptResult, error) {
+	req := InterceptOrderRequest{
+		OrderNo: orderNo,
+		Reason:  reason,
+		Action:  action,
+	}
+	if err := req.Validate(); err != nil {
+		return entity.InterceptResult{}, invalidInput(s.locale, err)
+	}
+
+	var res struct {
+		NormalResponse
+		Data entity.InterceptResult `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetBody(req).
+		SetResult(&res).
+		Post("/open-api/v2/order/intercept")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return entity.InterceptResult{}, err
+	}
+	return res.Data, nil
+}
+
+// InterceptStatus 拦截状态查询
+// @param orderNo GOFO 的运单号
+func (s orderService) InterceptStatus(ctx context.Context, orderNo string) (entity.InterceptResult, error) {
+	if orderNo == "" {
+		return entity.InterceptResult{}, localizedError(s.locale, "运单号不能为空")
+	}
+
+	var res struct {
+		NormalResponse
+		Data entity.InterceptResult `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetQueryParam("orderNo", orderNo).
+		SetResult(&res).
+		Get("/open-api/v2/order/intercept/status")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return entity.InterceptResult{}, err
+	}
+	return res.Data, nil
+}
+
 // ShippingLabel 获取面单
+// 面单按 opts 在本地转换(参见 LabelOptions), 返回结果中的 Format 为面单实际格式
 // @param orderNo 订单号/运单号/客户单号
-func (s orderService) ShippingLabel(ctx context.Context, orderNo string) (string, error) {
+// @param opts 面单选项, 不传时原样返回 GOFO 生成的面单
+func (s orderService) ShippingLabel(ctx context.Context, orderNo string, opts ...LabelOptions) (entity.Label, error) {
+	var opt LabelOptions
+	if len(opts) > 0 {
+		opt = opts[0]
+	}
+	if err := opt.Validate(); err != nil {
+		return entity.Label{}, invalidInput(s.locale, err)
+	}
+
 	var res struct {
 		NormalResponse
 		Data struct {
@@ -236,20 +973,29 @@ func (s orderService) ShippingLabel(ctx context.Context, orderNo string) (string
 		SetQueryParam("orderNo", orderNo).
 		SetResult(&res).
 		Get("/open-api/v2/order/getOrderLabelUrlV2")
-	if err = recheckError(resp, err); err != nil {
-		return "", err
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return entity.Label{}, err
 	}
 	if res.Data.Base64code == "" {
-		return "", errors.New("面单数据为空")
+		return entity.Label{}, localizedError(s.locale, "面单数据为空")
+	}
+
+	data, err := base64.StdEncoding.DecodeString(res.Data.Base64code)
+	if err != nil {
+		return entity.Label{}, fmt.Errorf("%s: %w", translate(s.locale, "面单数据解析失败"), err)
+	}
+	label, err := convertLabel(entity.Label{Format: detectLabelFormat(data), Data: data}, opt)
+	if err != nil {
+		return entity.Label{}, localizeError(s.locale, err)
 	}
-	return res.Data.Base64code, nil
+	return label, nil
 }
 
 // Tracks 轨迹查询
 // @param orderNo 订单号/运单号/客户单号
 func (s orderService) Tracks(ctx context.Context, orderNo string) ([]entity.TrackEvent, error) {
 	if orderNo == "" {
-		return nil, errors.New("查询单号不能为空")
+		return nil, localizedError(s.locale, "查询单号不能为空")
 	}
 	var res struct {
 		NormalResponse
@@ -259,7 +1005,203 @@ func (s orderService) Tracks(ctx context.Context, orderNo string) ([]entity.Trac
 		SetContext(ctx).
 		SetResult(&res).
 		Get(fmt.Sprintf("/open-api/v2/order/track/%s", orderNo))
-	if err = recheckError(resp, err); err != nil {
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
+
+// OrderListFilter 订单查询条件
+type OrderListFilter struct {
+	StartCreateTime DateTime    `json:"startCreateTime"`       // 创建开始时间, 零值时不提交
+	EndCreateTime   DateTime    `json:"endCreateTime"`         // 创建结束时间, 零值时不提交
+	Status          null.String `json:"status,omitempty"`      // 订单状态
+	ProductCode     null.String `json:"productCode,omitempty"` // 产品编码
+	ReferenceNo     null.String `json:"referenceNo,omitempty"` // 参考单号
+	PageSize        int         `json:"pageSize"`              // 每页数量, 范围 1-100, 默认为 50
+	Cursor          string      `json:"-"`                     // 分页游标, 为空时从第一条记录开始查询, 与 PageSize 无关
+	pageNo          int         // 页码, 由 Cursor 及 PageSize 计算
+}
+
+// MarshalJSON 创建时间为零值时不提交
+func (m OrderListFilter) MarshalJSON() ([]byte, error) {
+	type alias OrderListFilter
+	return json.Marshal(struct {
+		alias
+		StartCreateTime *DateTime `json:"startCreateTime,omitempty"`
+		EndCreateTime   *DateTime `json:"endCreateTime,omitempty"`
+		PageNo          int       `json:"pageNo"`
+	}{alias(m), m.StartCreateTime.orNil(), m.EndCreateTime.orNil(), m.pageNo})
+}
+
+func (m OrderListFilter) Validate() error {
+	err := validation.ValidateStruct(&m,
+		validation.Field(&m.EndCreateTime, validation.When(!m.StartCreateTime.IsZero() && !m.EndCreateTime.IsZero(), validation.By(func(interface{}) error {
+			if m.EndCreateTime.Before(m.StartCreateTime.Time) {
+				return validation.NewError("validation_end_time_before_start", "创建结束时间不能早于创建开始时间")
+			}
+			return nil
+		}))),
+		validation.Field(&m.PageSize, validation.When(m.PageSize != 0, validation.Min(1).Error("每页数量不能小于 {{.threshold}}"), validation.Max(100).Error("每页数量不能大于 {{.threshold}}"))),
+		validation.Field(&m.Cursor, validation.When(m.Cursor != "", validation.By(func(value interface{}) error {
+			_, err := parseOrderListCursor(value.(string))
+			return err
+		}))),
+	)
+	return renameFields(err, map[string]string{"Cursor": "cursor"})
+}
+
+// parseOrderListCursor 解析分页游标, 游标为已遍历的订单数量(即下一个订单在查询结果中的绝对位置)
+// 游标不依赖每页数量, 使用不同的 PageSize 继续查询时不会遗漏或重复订单
+func parseOrderListCursor(cursor string) (int, error) {
+	if cursor == "" {
+		return 0, nil
+	}
+
+	offset, err := strconv.Atoi(cursor)
+	if err != nil || offset < 0 {
+		return 0, validation.NewError("validation_cursor_invalid", "无效的分页游标 {{.cursor}}").SetParams(map[string]interface{}{"cursor": cursor})
+	}
+	return offset, nil
+}
+
+// List 订单查询
+// 返回的迭代器会自动翻页直至遍历完所有订单, 每个订单的 Cursor 可用于中断后继续查询
+func (s orderService) List(ctx context.Context, filter OrderListFilter) iter.Seq2[entity.Order, error] {
+	return func(yield func(entity.Order, error) bool) {
+		filter.StartCreateTime = filter.StartCreateTime.In(s.location)
+		filter.EndCreateTime = filter.EndCreateTime.In(s.location)
+		if err := filter.Validate(); err != nil {
+			yield(entity.Order{}, invalidInput(s.locale, err))
+			return
+		}
+
+		if filter.PageSize == 0 {
+			filter.PageSize = 50
+		}
+		start, _ := parseOrderListCursor(filter.Cursor)
+		pageNo, offset := start/filter.PageSize+1, start%filter.PageSize
+		for {
+			if err := ctx.Err(); err != nil {
+				yield(entity.Order{}, err)
+				return
+			}
+
+			filter.pageNo = pageNo
+			var res struct {
+				NormalResponse
+				Data struct {
+					Total   int            `json:"total"`
+					Records []entity.Order `json:"records"`
+				} `json:"data"`
+			}
+			resp, err := s.httpClient.R().
+				SetContext(ctx).
+				SetBody(filter).
+				SetResult(&res).
+				Post("/open-api/v2/order/list")
+			if err = recheckError(s.locale, resp, err); err != nil {
+				yield(entity.Order{}, err)
+				return
+			}
+
+			records := res.Data.Records
+			for i := offset; i < len(records); i++ {
+				order := records[i]
+				order.Cursor = strconv.Itoa((pageNo-1)*filter.PageSize + i + 1)
+				if !yield(order, nil) {
+					return
+				}
+			}
+			if len(records) < filter.PageSize || pageNo*filter.PageSize >= res.Data.Total {
+				return
+			}
+			pageNo++
+			offset = 0
+		}
+	}
+}
+
+// ProofOfDelivery 签收证明查询
+// @param orderNo 订单号/运单号/客户单号
+func (s orderService) ProofOfDelivery(ctx context.Context, orderNo string) (entity.ProofOfDelivery, error) {
+	if orderNo == "" {
+		return entity.ProofOfDelivery{}, localizedError(s.locale, "查询单号不能为空")
+	}
+
+	var res struct {
+		NormalResponse
+		Data entity.ProofOfDelivery `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetQueryParam("orderNo", orderNo).
+		SetResult(&res).
+		Get("/open-api/v2/order/pod")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return entity.ProofOfDelivery{}, err
+	}
+
+	pod := res.Data
+	for i, img := range pod.Images {
+		pod.Images[i].Data, err = base64.StdEncoding.DecodeString(img.Base64code)
+		if err != nil {
+			return entity.ProofOfDelivery{}, fmt.Errorf("%s: %w", translate(s.locale, "签收图片数据解析失败"), err)
+		}
+	}
+	return pod, nil
+}
+
+// ProofOfDeliveryPDF 将签收信息及签收图片合并为一个 PDF 文件, 用于拒付争议举证
+func (s orderService) ProofOfDeliveryPDF(pod entity.ProofOfDelivery) ([]byte, error) {
+	lines := []string{
+		"Proof of Delivery",
+		"",
+		"Waybill No: " + pod.OrderNo,
+		"Delivery Time: " + pod.DeliveryTime,
+		"Signer: " + pod.Signer,
+		"Signer Type: " + pod.SignerType,
+		"PIN: " + pod.Pin,
+	}
+	if pod.HasLocation() {
+		lines = append(lines, fmt.Sprintf("Location: %.6f, %.6f", *pod.Latitude, *pod.Longitude))
+	}
+
+	pages := []pdfPage{{lines: lines}}
+	for i, img := range pod.Images {
+		image, err := newPDFImage(img.Data)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", localizedMessage(s.locale, "签收图片 {{.index}} 解析失败", map[string]interface{}{"index": i + 1}), err)
+		}
+		pages = append(pages, pdfPage{
+			lines: []string{fmt.Sprintf("%s (%d/%d)", img.Type, i+1, len(pod.Images))},
+			image: image,
+		})
+	}
+	return buildPDF(pages), nil
+}
+
+// Measurements GOFO 实际测量的重量及尺寸查询
+// @param waybillNos GOFO 的运单号
+func (s orderService) Measurements(ctx context.Context, waybillNos []string) ([]entity.Measurement, error) {
+	err := validation.Validate(waybillNos,
+		validation.Required.Error("运单号不能为空"),
+		validation.Each(validation.Required.Error("运单号不能为空")),
+	)
+	if err != nil {
+		return nil, invalidInput(s.locale, err)
+	}
+
+	var res struct {
+		NormalResponse
+		Data []entity.Measurement `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetBody(map[string][]string{"waybillNos": waybillNos}).
+		SetResult(&res).
+		Post("/open-api/v2/order/measurement")
+	if err = recheckError(s.locale, resp, err); err != nil {
 		return nil, err
 	}
 	return res.Data, nil
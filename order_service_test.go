@@ -1,9 +1,17 @@
 package gofo
 
 import (
+	"bytes"
+	"encoding/json"
+	"errors"
 	"fmt"
+	"net/http"
+	"slices"
+	"strings"
 	"testing"
+	"time"
 
+	"github.com/hiscaler/gofo-go/entity"
 	"gopkg.in/guregu/null.v4"
 )
 
@@ -18,14 +26,14 @@ func TestOrderService_Create(t *testing.T) {
 			ShipperState:   "Guangdong",
 			ShipperCity:    "Shenzhen",
 			ShipperStreet:  "test street",
-			ShipperCode:    "90058",
+			ShipperCode:    "518000",
 		},
 		ProductCode: null.StringFrom("GOFO Parcel Pickup"),
 		OrderConsignee: OrderConsignee{
 			ConsigneeName:    "test",
-			ConsigneePhone:   "13000000000",
+			ConsigneePhone:   "3000000000",
 			ConsigneeCountry: "US",
-			ConsigneeState:   "California",
+			ConsigneeState:   "CA",
 			ConsigneeCity:    "Los Angeles",
 			Address1:         "test address",
 			ConsigneeCode:    "90001",
@@ -51,6 +59,49 @@ func TestOrderService_Create(t *testing.T) {
 	fmt.Println(resp)
 }
 
+func TestOrderService_CreateMultiPiece(t *testing.T) {
+	req := MultiPieceOrderRequest{
+		Order: CreateOrderRequest{
+			COrderNo:      null.StringFrom("TEST_ORDER_002"),
+			DeclaredValue: 12,
+			OrderShipper: OrderShipper{
+				ShipperName:    "test",
+				ShipperPhone:   "13000000000",
+				ShipperCountry: "CN",
+				ShipperState:   "Guangdong",
+				ShipperCity:    "Shenzhen",
+				ShipperStreet:  "test street",
+				ShipperCode:    "518000",
+			},
+			ProductCode: null.StringFrom("GOFO Parcel Pickup"),
+			OrderConsignee: OrderConsignee{
+				ConsigneeName:    "test",
+				ConsigneePhone:   "3000000000",
+				ConsigneeCountry: "US",
+				ConsigneeState:   "CA",
+				ConsigneeCity:    "Los Angeles",
+				Address1:         "test address",
+				ConsigneeCode:    "90001",
+			},
+		},
+		Parcels: []Parcel{
+			{
+				OrderGoods:    OrderGoods{Weight: 1, Length: 10, Height: 10, Width: 10},
+				OrderItemList: []OrderItem{{ItemNameEn: "test", ItemNameZh: "测试", ItemQty: 1}},
+			},
+			{
+				OrderGoods:    OrderGoods{Weight: 2, Length: 20, Height: 10, Width: 10},
+				OrderItemList: []OrderItem{{ItemNameEn: "test", ItemNameZh: "测试", ItemQty: 2}},
+			},
+		},
+	}
+	shipment, err := client.Services.Order.CreateMultiPiece(ctx, req)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	fmt.Println(shipment)
+}
+
 func TestOrderService_Cancel(t *testing.T) {
 	req := CancelOrderRequest{
 		OrderNo: "GFUS01014625997824",
@@ -61,11 +112,99 @@ func TestOrderService_Cancel(t *testing.T) {
 	}
 }
 
+func TestOrderService_Update(t *testing.T) {
+	patch := OrderPatch{
+		OrderConsignee: &OrderConsignee{
+			ConsigneeName:    "test",
+			ConsigneePhone:   "3000000000",
+			ConsigneeCountry: "US",
+			ConsigneeState:   "CA",
+			ConsigneeCity:    "Los Angeles",
+			Address1:         "test address 2",
+			ConsigneeCode:    "90001",
+		},
+		Remarks: null.StringFrom("address corrected"),
+	}
+	_, err := client.Services.Order.Update(ctx, "GFUS01014625997824", patch)
+	if err != nil {
+		var notEditable *OrderNotEditableError
+		if !errors.As(err, &notEditable) {
+			t.Errorf("Expected no error, got %v", err)
+		}
+	}
+}
+
+func TestOrderPatch_Validate(t *testing.T) {
+	var ve *ValidationError
+	if !errors.As(invalidInput(LocaleEnUS, OrderPatch{}.Validate()), &ve) {
+		t.Fatalf("Expected *ValidationError")
+	}
+	if len(ve.Fields) != 1 || ve.Fields[0].Path != "" || ve.Fields[0].Rule != "validation_order_patch_empty" {
+		t.Errorf("Expected struct level empty patch error, got %+v", ve.Fields)
+	}
+	if err := (OrderPatch{Remarks: null.StringFrom("address corrected")}).Validate(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestOrderService_UpdateNotEditable(t *testing.T) {
+	tracks := `[]`
+	svc := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		if r.URL.Path == "/open-api/v2/order/update" {
+			fmt.Fprint(w, `{"code":500,"msg":"修改失败"}`)
+			return
+		}
+		fmt.Fprintf(w, `{"code":200,"data":%s}`, tracks)
+	})
+	s := orderService(svc)
+	patch := OrderPatch{Remarks: null.StringFrom("address corrected")}
+
+	var notEditable *OrderNotEditableError
+	if _, err := s.Update(ctx, "GFUS01014625997824", patch); err == nil || errors.As(err, &notEditable) {
+		t.Errorf("Expected plain error for order without tracks, got %v", err)
+	}
+
+	tracks = `[{"operationMove":"100","operationTime":"2024-01-01 10:00:00"}]`
+	if _, err := s.Update(ctx, "GFUS01014625997824", patch); err == nil || errors.As(err, &notEditable) {
+		t.Errorf("Expected plain error for order not yet picked up, got %v", err)
+	}
+
+	tracks = `[{"operationMove":"100","operationTime":"2024-01-01 10:00:00"},{"operationMove":"999","operationTime":"2024-01-02 10:00:00"}]`
+	if _, err := s.Update(ctx, "GFUS01014625997824", patch); err == nil || errors.As(err, &notEditable) {
+		t.Errorf("Expected plain error for unknown track code, got %v", err)
+	}
+
+	tracks = `[{"operationMove":"202","operationTime":"2024-01-02 10:00:00"},{"operationMove":"200","operationTime":"2024-01-03 10:00:00"},{"operationMove":"100","operationTime":"2024-01-01 10:00:00"}]`
+	_, err := s.Update(ctx, "GFUS01014625997824", patch)
+	if !errors.As(err, &notEditable) {
+		t.Fatalf("Expected *OrderNotEditableError, got %v", err)
+	}
+	if notEditable.Status != entity.TrackCodeWarehouseCheckOut || notEditable.Code != 500 {
+		t.Errorf("Unexpected error %+v", notEditable)
+	}
+}
+
+func TestOrderService_Intercept(t *testing.T) {
+	_, err := client.Services.Order.Intercept(ctx, "GFUS01014625997824", "fraud order", entity.InterceptActionReturnToSender)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestOrderService_InterceptStatus(t *testing.T) {
+	_, err := client.Services.Order.InterceptStatus(ctx, "GFUS01014625997824")
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
 func TestOrderService_ShippingLabel(t *testing.T) {
-	_, err := client.Services.Order.ShippingLabel(ctx, "GFUS01014625997824")
+	label, err := client.Services.Order.ShippingLabel(ctx, "GFUS01014625997824")
 	if err != nil {
 		t.Errorf("Expected no error, got %v", err)
 	}
+	fmt.Println(label.Format, len(label.Data))
 }
 
 func TestOrderService_Track(t *testing.T) {
@@ -74,3 +213,318 @@ func TestOrderService_Track(t *testing.T) {
 		t.Errorf("Expected no error, got %v", err)
 	}
 }
+
+func TestOrderService_List(t *testing.T) {
+	filter := OrderListFilter{
+		StartCreateTime: NewDateTime(time.Date(2025, 6, 1, 0, 0, 0, 0, time.Local)),
+		EndCreateTime:   NewDateTime(time.Date(2025, 6, 1, 23, 59, 59, 0, time.Local)),
+	}
+	for order, err := range client.Services.Order.List(ctx, filter) {
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		fmt.Println(order.WaybillNo, order.Status, order.Cursor)
+	}
+}
+
+func TestOrderService_ProofOfDelivery(t *testing.T) {
+	pod, err := client.Services.Order.ProofOfDelivery(ctx, "GFUS01014625997824")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	b, err := client.Services.Order.ProofOfDeliveryPDF(pod)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !bytes.HasPrefix(b, []byte("%PDF-")) {
+		t.Errorf("Expected PDF document")
+	}
+}
+
+func TestOrderService_ProofOfDeliveryPDFLocale(t *testing.T) {
+	pod := entity.ProofOfDelivery{Images: []entity.PODImage{{Type: entity.PODImageTypePhoto, Data: []byte("invalid")}}}
+	_, err := orderService{locale: LocaleEnUS}.ProofOfDeliveryPDF(pod)
+	if err == nil || !strings.HasPrefix(err.Error(), "Failed to decode proof of delivery image 1") {
+		t.Errorf("Expected en-US image error, got %v", err)
+	}
+}
+
+func TestOrderService_Measurements(t *testing.T) {
+	measurements, err := client.Services.Order.Measurements(ctx, []string{"GFUS01014625997824"})
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	fmt.Println(measurements)
+}
+
+func TestCreateOrderRequest_ItemsValue(t *testing.T) {
+	items := []OrderItem{
+		{ItemNameEn: "shirt", ItemNameZh: "衬衫", ItemQty: 2, UnitValue: null.FloatFrom(3), Sku: null.StringFrom("SHIRT-01")},
+		{ItemNameEn: "hat", ItemNameZh: "帽子", ItemQty: 1, UnitValue: null.FloatFrom(4), Sku: null.StringFrom("HAT-01")},
+	}
+	if err := validItemsValue(items, 10).Validate(nil); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	if err := validItemsValue(items, 12).Validate(nil); err == nil {
+		t.Errorf("Expected items value mismatch error")
+	}
+	if summary := skuSummary(items, 255); summary != "SHIRT-01*2;HAT-01*1" {
+		t.Errorf("Unexpected SKU summary %s", summary)
+	}
+	if summary := skuSummary(items, 12); summary != "SHIRT-01*..." {
+		t.Errorf("Unexpected truncated SKU summary %s", summary)
+	}
+}
+
+func TestMultiPieceOrderRequest_Pieces(t *testing.T) {
+	req := MultiPieceOrderRequest{
+		Order: CreateOrderRequest{
+			COrderNo:       null.StringFrom("TEST_ORDER_003"),
+			DeclaredValue:  10,
+			OrderInsurance: &OrderInsurance{InsuredAmount: 100},
+		},
+		Parcels: []Parcel{
+			{OrderItemList: []OrderItem{{ItemQty: 2, UnitValue: null.FloatFrom(2)}}},
+			{},
+			{InsuredAmount: null.FloatFrom(50)},
+		},
+	}
+	pieces := req.pieces()
+	declaredValues := []float64{4, 3, 3}
+	insuredAmounts := []float64{25, 25, 50}
+	for i, piece := range pieces {
+		if piece.DeclaredValue != declaredValues[i] {
+			t.Errorf("Piece %d: expected declared value %v, got %v", i+1, declaredValues[i], piece.DeclaredValue)
+		}
+		if piece.OrderInsurance.InsuredAmount != insuredAmounts[i] {
+			t.Errorf("Piece %d: expected insured amount %v, got %v", i+1, insuredAmounts[i], piece.OrderInsurance.InsuredAmount)
+		}
+	}
+	if pieces[0].OrderInsurance == req.Order.OrderInsurance {
+		t.Errorf("Expected each piece to have its own insurance")
+	}
+
+	shares := splitAmount(10, make([]null.Float, 3))
+	if shares[0] != 3.3333 || shares[2] != 3.3334 {
+		t.Errorf("Unexpected shares %v", shares)
+	}
+}
+
+func TestMultiPieceOrderRequest_Validate(t *testing.T) {
+	req := MultiPieceOrderRequest{Parcels: []Parcel{{}}}
+	var ve *ValidationError
+	if !errors.As(invalidInput(LocaleEnUS, req.Validate()), &ve) {
+		t.Fatalf("Expected *ValidationError")
+	}
+	if order := ve.Fields[0]; order.Path != "order" || order.Rule != "validation_multi_piece_order_no_required" {
+		t.Errorf("Unexpected field error %+v", order)
+	}
+	if !slices.ContainsFunc(ve.Fields, func(f FieldError) bool { return f.Path == "parcels[0].orderGoods.weight" }) {
+		t.Errorf("Expected parcel weight error, got %+v", ve.Fields)
+	}
+
+	req = MultiPieceOrderRequest{
+		Order: CreateOrderRequest{
+			COrderNo:       null.StringFrom("TEST_ORDER_004"),
+			DeclaredValue:  10,
+			OrderInsurance: &OrderInsurance{InsuredAmount: 100},
+		},
+		Parcels: []Parcel{
+			{DeclaredValue: null.FloatFrom(12), InsuredAmount: null.FloatFrom(60)},
+			{DeclaredValue: null.FloatFrom(2), InsuredAmount: null.FloatFrom(30)},
+		},
+	}
+	ve = nil
+	if !errors.As(invalidInput(LocaleEnUS, req.Validate()), &ve) {
+		t.Fatalf("Expected *ValidationError")
+	}
+	for _, path := range []string{"order.declaredValue", "order.orderInsurance.insuredAmount", "parcels[0].declaredValue"} {
+		if !slices.ContainsFunc(ve.Fields, func(f FieldError) bool { return f.Path == path }) {
+			t.Errorf("Expected %s error, got %+v", path, ve.Fields)
+		}
+	}
+
+	amountErrors := func() []string {
+		var paths []string
+		if err := req.Validate(); errors.As(invalidInput(LocaleEnUS, err), &ve) {
+			for _, f := range ve.Fields {
+				if strings.HasSuffix(f.Path, "declaredValue") || strings.HasSuffix(f.Path, "insuredAmount") {
+					paths = append(paths, f.Path)
+				}
+			}
+		}
+		return paths
+	}
+	req.Parcels[0].DeclaredValue = null.FloatFrom(8)
+	req.Parcels[0].InsuredAmount = null.FloatFrom(70)
+	if paths := amountErrors(); len(paths) != 0 {
+		t.Errorf("Expected amounts adding up to the totals to be valid, got %v", paths)
+	}
+
+	req.Parcels[0].DeclaredValue = null.FloatFrom(3)
+	req.Parcels[1].DeclaredValue = null.Float{}
+	req.Parcels[1].InsuredAmount = null.FloatFrom(20)
+	if paths := amountErrors(); len(paths) != 1 || paths[0] != "order.orderInsurance.insuredAmount" {
+		t.Errorf("Expected only insured amount mismatch, got %v", paths)
+	}
+}
+
+func TestValidatePieces(t *testing.T) {
+	item := OrderItem{ItemNameEn: "test", ItemNameZh: "测试", ItemQty: 1}
+	req := MultiPieceOrderRequest{
+		Order: CreateOrderRequest{
+			COrderNo:      null.StringFrom("TEST_ORDER_0000000000000000_1"),
+			DeclaredValue: 20,
+			OrderShipper: OrderShipper{
+				ShipperName:    "test",
+				ShipperPhone:   "13000000000",
+				ShipperCountry: "CN",
+				ShipperState:   "Guangdong",
+				ShipperCity:    "Shenzhen",
+				ShipperStreet:  "test street",
+				ShipperCode:    "518000",
+			},
+			OrderConsignee: OrderConsignee{
+				ConsigneeName:    "test",
+				ConsigneePhone:   "5552101234",
+				ConsigneeCountry: "US",
+				ConsigneeState:   "California",
+				ConsigneeCity:    "Los Angeles",
+				Address1:         "test address",
+				ConsigneeCode:    "90001",
+			},
+		},
+		Parcels: []Parcel{
+			{OrderGoods: OrderGoods{Weight: 1, Length: 1, Height: 1, Width: 1}, OrderItemList: []OrderItem{item}},
+			{OrderGoods: OrderGoods{Length: 1, Height: 1, Width: 1}, OrderItemList: []OrderItem{item}},
+		},
+	}
+	var ve *ValidationError
+	if !errors.As(invalidInput(LocaleEnUS, validatePieces(req.pieces())), &ve) {
+		t.Fatalf("Expected *ValidationError")
+	}
+	paths := make([]string, len(ve.Fields))
+	for i, field := range ve.Fields {
+		paths[i] = field.Path
+	}
+	expected := []string{"order.cOrderNo", "order.orderConsignee.consigneeState", "parcels[1].orderGoods.weight"}
+	if !slices.Equal(paths, expected) {
+		t.Errorf("Expected paths %v, got %v", expected, paths)
+	}
+	if ve.Fields[0].Rule != "validation_multi_piece_order_no_too_long" {
+		t.Errorf("Unexpected order number error %+v", ve.Fields[0])
+	}
+}
+
+func TestOrderService_CreateMultiPieceOptions(t *testing.T) {
+	addressChecks, created := 0, 0
+	svc := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		switch r.URL.Path {
+		case "/open-api/v2/address/check":
+			addressChecks++
+			fmt.Fprint(w, `{"code":200,"data":{"corrections":[{"field":"consigneeState","original":"California","corrected":"CA","safe":true}]}}`)
+		case "/open-api/v2/order/create":
+			created++
+			fmt.Fprintf(w, `{"code":200,"data":{"waybillNo":"GF%d"}}`, created)
+		}
+	})
+	svc.locale = LocaleEnUS
+	s := orderService(svc)
+
+	item := OrderItem{ItemNameEn: "test", ItemNameZh: "测试", ItemQty: 1}
+	req := MultiPieceOrderRequest{
+		Order: CreateOrderRequest{
+			COrderNo:      null.StringFrom("TEST_ORDER_004"),
+			DeclaredValue: 20,
+			OrderShipper: OrderShipper{
+				ShipperName:    "test",
+				ShipperPhone:   "13000000000",
+				ShipperCountry: "CN",
+				ShipperState:   "Guangdong",
+				ShipperCity:    "Shenzhen",
+				ShipperStreet:  "test street",
+				ShipperCode:    "518000",
+			},
+			OrderConsignee: OrderConsignee{
+				ConsigneeName:    "test",
+				ConsigneePhone:   "5552101234",
+				ConsigneeCountry: "US",
+				ConsigneeState:   "California",
+				ConsigneeCity:    "Los Angeles",
+				Address1:         "test address",
+				ConsigneeCode:    "90001",
+			},
+		},
+		Parcels: []Parcel{
+			{OrderGoods: OrderGoods{Weight: 1, Length: 1, Height: 1, Width: 1}, OrderItemList: []OrderItem{item}},
+			{OrderGoods: OrderGoods{Weight: 1, Length: 1, Height: 1, Width: 1}, OrderItemList: []OrderItem{item}},
+		},
+	}
+	shipment, err := s.CreateMultiPiece(ctx, req, WithAddressCorrection())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if addressChecks != 1 || len(shipment.Pieces) != 2 || shipment.MasterWaybillNo != "GF1" {
+		t.Errorf("Unexpected shipment %+v with %d address checks", shipment, addressChecks)
+	}
+
+	// 货物的预报货值与物品总价不一致只能在生成每件货物的订单后检查
+	req.Parcels[1].DeclaredValue = null.FloatFrom(5)
+	req.Parcels[1].OrderItemList = []OrderItem{{ItemNameEn: "test", ItemNameZh: "测试", ItemQty: 1, UnitValue: null.FloatFrom(2)}}
+	var ve *ValidationError
+	if _, err = s.CreateMultiPiece(ctx, req, WithAddressCorrection()); !errors.As(err, &ve) {
+		t.Fatalf("Expected *ValidationError, got %v", err)
+	}
+	if len(ve.Fields) != 1 || ve.Fields[0].Path != "parcels[1].orderItemList" {
+		t.Errorf("Unexpected field errors %+v", ve.Fields)
+	}
+}
+
+func TestOrderService_ListCursor(t *testing.T) {
+	const total = 7
+	svc := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		var filter struct {
+			PageNo   int `json:"pageNo"`
+			PageSize int `json:"pageSize"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&filter); err != nil {
+			w.WriteHeader(http.StatusBadRequest)
+			return
+		}
+		records := make([]entity.Order, 0)
+		for i := (filter.PageNo-1)*filter.PageSize + 1; i <= min(filter.PageNo*filter.PageSize, total); i++ {
+			records = append(records, entity.Order{WaybillNo: fmt.Sprintf("GF%d", i)})
+		}
+		b, _ := json.Marshal(records)
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprintf(w, `{"code":200,"data":{"total":%d,"records":%s}}`, total, b)
+	})
+	s := orderService(svc)
+
+	var cursor string
+	for order, err := range s.List(ctx, OrderListFilter{PageSize: 3}) {
+		if err != nil {
+			t.Fatalf("Expected no error, got %v", err)
+		}
+		if cursor = order.Cursor; order.WaybillNo == "GF4" {
+			break
+		}
+	}
+	for _, pageSize := range []int{2, 0} {
+		waybillNos := make([]string, 0)
+		for order, err := range s.List(ctx, OrderListFilter{PageSize: pageSize, Cursor: cursor}) {
+			if err != nil {
+				t.Fatalf("Expected no error, got %v", err)
+			}
+			waybillNos = append(waybillNos, order.WaybillNo)
+		}
+		if !slices.Equal(waybillNos, []string{"GF5", "GF6", "GF7"}) {
+			t.Errorf("Page size %d: unexpected orders %v after cursor %s", pageSize, waybillNos, cursor)
+		}
+	}
+
+	if paths := errorPaths(t, OrderListFilter{Cursor: "1:2"}.Validate()); !slices.Equal(paths, []string{"cursor"}) {
+		t.Errorf("Expected invalid cursor error, got %v", paths)
+	}
+}
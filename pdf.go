@@ -0,0 +1,168 @@
+package gofo
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/jpeg"
+	_ "image/png"
+	"strings"
+)
+
+// pdfImage PDF 页面中的图片, 统一以 JPEG 格式嵌入
+type pdfImage struct {
+	data          []byte
+	width, height int
+	colorSpace    string
+}
+
+// newPDFImage 解析图片数据, 非 JPEG 格式的图片会被转换为 JPEG
+// JPEG 不支持透明通道, 除灰度图片外均先合成到白色背景上, 避免透明区域变为黑色
+func newPDFImage(data []byte) (*pdfImage, error) {
+	img, format, err := image.Decode(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if format != "jpeg" {
+		if _, ok := img.(*image.Gray); !ok {
+			img = flattenImage(img)
+		}
+		var buf bytes.Buffer
+		if err = jpeg.Encode(&buf, img, &jpeg.Options{Quality: 90}); err != nil {
+			return nil, err
+		}
+		data = buf.Bytes()
+	}
+	colorSpace := "DeviceRGB"
+	switch img.ColorModel() {
+	case color.GrayModel:
+		colorSpace = "DeviceGray"
+	case color.CMYKModel:
+		colorSpace = "DeviceCMYK"
+	}
+	b := img.Bounds()
+	return &pdfImage{data: data, width: b.Dx(), height: b.Dy(), colorSpace: colorSpace}, nil
+}
+
+// pdfPage PDF 页面, 文字位于页面上方, 图片按比例缩放至剩余区域
+type pdfPage struct {
+	width, height float64
+	lines         []string
+	image         *pdfImage
+	bleed         bool // 图片是否铺满整个页面(忽略页边距及文字)
+}
+
+const (
+	pdfLetterWidth  = 612.0
+	pdfLetterHeight = 792.0
+	pdfMargin       = 40.0
+	pdfFontSize     = 12.0
+	pdfLineHeight   = 16.0
+)
+
+// pdfWinAnsiExtra WinAnsiEncoding 中 0x80-0x9F 区间的常用字符
+var pdfWinAnsiExtra = map[rune]byte{
+	'€': 0x80, '‚': 0x82, 'ƒ': 0x83, '„': 0x84, '…': 0x85, '†': 0x86, '‡': 0x87, 'ˆ': 0x88,
+	'‰': 0x89, 'Š': 0x8A, '‹': 0x8B, 'Œ': 0x8C, 'Ž': 0x8E, '‘': 0x91, '’': 0x92, '“': 0x93,
+	'”': 0x94, '•': 0x95, '–': 0x96, '—': 0x97, '˜': 0x98, '™': 0x99, 'š': 0x9A, '›': 0x9B,
+	'œ': 0x9C, 'ž': 0x9E, 'Ÿ': 0x9F,
+}
+
+// pdfEscape 将字符串编码为 WinAnsiEncoding 的 PDF 字符串, 非 ASCII 字符以八进制转义
+// 内置字体仅支持拉丁字符(例如 José Muñoz), 中日韩等其他字符无法显示, 替换为 ?
+func pdfEscape(s string) string {
+	var sb strings.Builder
+	for _, r := range s {
+		switch {
+		case r == '(' || r == ')' || r == '\\':
+			sb.WriteByte('\\')
+			sb.WriteRune(r)
+		case r >= 32 && r <= 126:
+			sb.WriteRune(r)
+		case r >= 160 && r <= 255:
+			fmt.Fprintf(&sb, "\\%03o", r)
+		default:
+			if b, ok := pdfWinAnsiExtra[r]; ok {
+				fmt.Fprintf(&sb, "\\%03o", b)
+			} else {
+				sb.WriteByte('?')
+			}
+		}
+	}
+	return sb.String()
+}
+
+// buildPDF 生成 PDF 文档
+func buildPDF(pages []pdfPage) []byte {
+	var buf bytes.Buffer
+	offsets := make([]int, 0)
+	newObject := func(body string, stream []byte) {
+		offsets = append(offsets, buf.Len())
+		fmt.Fprintf(&buf, "%d 0 obj\n%s\n", len(offsets), body)
+		if stream != nil {
+			buf.WriteString("stream\n")
+			buf.Write(stream)
+			buf.WriteString("\nendstream\n")
+		}
+		buf.WriteString("endobj\n")
+	}
+
+	buf.WriteString("%PDF-1.4\n")
+	// 1: Catalog, 2: Pages, 3: Font, 之后每页依次为 Page、Contents、Image(可选)
+	kids := make([]string, len(pages))
+	next := 4
+	for i, page := range pages {
+		kids[i] = fmt.Sprintf("%d 0 R", next)
+		next += 2
+		if page.image != nil {
+			next++
+		}
+	}
+	newObject("<< /Type /Catalog /Pages 2 0 R >>", nil)
+	newObject(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(pages)), nil)
+	newObject("<< /Type /Font /Subtype /Type1 /BaseFont /Helvetica /Encoding /WinAnsiEncoding >>", nil)
+
+	for _, page := range pages {
+		width, height := page.width, page.height
+		if width == 0 || height == 0 {
+			width, height = pdfLetterWidth, pdfLetterHeight
+		}
+		pageObj := len(offsets) + 1
+		resources := "/Font << /F1 3 0 R >>"
+		if page.image != nil {
+			resources += fmt.Sprintf(" /XObject << /Im1 %d 0 R >>", pageObj+2)
+		}
+
+		var content bytes.Buffer
+		y := height - pdfMargin - pdfFontSize
+		for _, line := range page.lines {
+			fmt.Fprintf(&content, "BT /F1 %.0f Tf %.2f %.2f Td (%s) Tj ET\n", pdfFontSize, pdfMargin, y, pdfEscape(line))
+			y -= pdfLineHeight
+		}
+		if page.image != nil {
+			if page.bleed {
+				fmt.Fprintf(&content, "q %.2f 0 0 %.2f 0 0 cm /Im1 Do Q\n", width, height)
+			} else {
+				boxWidth, boxHeight := width-2*pdfMargin, y-pdfMargin
+				scale := min(boxWidth/float64(page.image.width), boxHeight/float64(page.image.height))
+				w, h := float64(page.image.width)*scale, float64(page.image.height)*scale
+				fmt.Fprintf(&content, "q %.2f 0 0 %.2f %.2f %.2f cm /Im1 Do Q\n", w, h, pdfMargin, y-h)
+			}
+		}
+
+		newObject(fmt.Sprintf("<< /Type /Page /Parent 2 0 R /MediaBox [0 0 %.2f %.2f] /Resources << %s >> /Contents %d 0 R >>", width, height, resources, pageObj+1), nil)
+		newObject(fmt.Sprintf("<< /Length %d >>", content.Len()), content.Bytes())
+		if page.image != nil {
+			newObject(fmt.Sprintf("<< /Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /%s /BitsPerComponent 8 /Filter /DCTDecode /Length %d >>", page.image.width, page.image.height, page.image.colorSpace, len(page.image.data)), page.image.data)
+		}
+	}
+
+	xref := buf.Len()
+	fmt.Fprintf(&buf, "xref\n0 %d\n0000000000 65535 f \n", len(offsets)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(&buf, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(&buf, "trailer\n<< /Size %d /Root 1 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(offsets)+1, xref)
+	return buf.Bytes()
+}
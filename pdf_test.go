@@ -0,0 +1,44 @@
+package gofo
+
+import (
+	"bytes"
+	"image"
+	"image/jpeg"
+	"image/png"
+	"testing"
+)
+
+func TestNewPDFImage(t *testing.T) {
+	// 完全透明的 PNG 图片应以白色背景嵌入
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, image.NewNRGBA(image.Rect(0, 0, 8, 8))); err != nil {
+		t.Fatal(err)
+	}
+	pdfImg, err := newPDFImage(buf.Bytes())
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	img, err := jpeg.Decode(bytes.NewReader(pdfImg.data))
+	if err != nil {
+		t.Fatalf("Expected JPEG data, got %v", err)
+	}
+	if r, g, b, _ := img.At(4, 4).RGBA(); r>>8 < 250 || g>>8 < 250 || b>>8 < 250 {
+		t.Errorf("Expected white background, got %d,%d,%d", r>>8, g>>8, b>>8)
+	}
+	if pdfImg.colorSpace != "DeviceRGB" {
+		t.Errorf("Expected DeviceRGB, got %s", pdfImg.colorSpace)
+	}
+}
+
+func TestPDFEscape(t *testing.T) {
+	for s, expected := range map[string]string{
+		"Signer: (test)": `Signer: \(test\)`,
+		"José Muñoz":     `Jos\351 Mu\361oz`,
+		"“OK” – €5":      `\223OK\224 \226 \2005`,
+		"张三":             "??",
+	} {
+		if escaped := pdfEscape(s); escaped != expected {
+			t.Errorf("%s: expected %s, got %s", s, expected, escaped)
+		}
+	}
+}
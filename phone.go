@@ -0,0 +1,167 @@
+package gofo
+
+import (
+	"encoding/json"
+	"regexp"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// phoneRule 各国家电话号码规则
+type phoneRule struct {
+	callingCode string         // 国际区号
+	trunkPrefix string         // 国内长途前缀
+	minLength   int            // 国内号码最小长度
+	maxLength   int            // 国内号码最大长度
+	pattern     *regexp.Regexp // 国内号码格式
+}
+
+var phoneRules = map[string]phoneRule{
+	"US": {callingCode: "1", minLength: 10, maxLength: 10, pattern: regexp.MustCompile(`^[2-9]\d{9}$`)},
+	"CA": {callingCode: "1", minLength: 10, maxLength: 10, pattern: regexp.MustCompile(`^[2-9]\d{9}$`)},
+	"MX": {callingCode: "52", minLength: 10, maxLength: 10},
+	"CN": {callingCode: "86", trunkPrefix: "0", minLength: 10, maxLength: 12},
+	"GB": {callingCode: "44", trunkPrefix: "0", minLength: 10, maxLength: 10},
+}
+
+// defaultPhoneRule 未配置规则的国家, 仅检查 E.164 允许的长度
+var defaultPhoneRule = phoneRule{minLength: 7, maxLength: 15}
+
+var phoneCharsRegexp = regexp.MustCompile(`^\+?[\d\s\-().]+$`)
+
+// Phone 电话号码
+type Phone struct {
+	CountryCode string // 国际区号, 例如美国为 1, 未知时为空
+	Number      string // 国内号码, 不包含国际区号及长途前缀; 国际区号未知且以国际格式输入时为包含国际区号的完整号码
+
+	international bool // Number 是否为包含国际区号的完整号码
+}
+
+// E164 E.164 格式, 例如 +15550101234, 无法确定国际区号时返回空字符串
+func (p Phone) E164() string {
+	switch {
+	case p.CountryCode != "":
+		return "+" + p.CountryCode + p.Number
+	case p.international:
+		return "+" + p.Number
+	}
+	return ""
+}
+
+// String GOFO 接受的格式, 即不包含国际区号的国内号码
+func (p Phone) String() string {
+	return p.Number
+}
+
+// ParsePhone 根据国家解析电话号码, 支持 "+1 (555) 010-1234"、"555.010.1234" 等常见格式
+// @param country 国家二字码, 例如 US
+func ParsePhone(raw, country string) (Phone, error) {
+	s := strings.TrimSpace(raw)
+	if s == "" {
+		return Phone{}, validation.NewError("validation_phone_required", "号码不能为空")
+	}
+	if !phoneCharsRegexp.MatchString(s) {
+		return Phone{}, validation.NewError("validation_phone_chars", "号码 {{.phone}} 包含无效字符, 只能包含数字、空格及 + - ( ) .").SetParams(map[string]interface{}{"phone": raw})
+	}
+
+	international := strings.HasPrefix(s, "+")
+	digits := strings.Map(func(r rune) rune {
+		if r >= '0' && r <= '9' {
+			return r
+		}
+		return -1
+	}, s)
+	if !international && strings.HasPrefix(digits, "00") {
+		international = true
+		digits = digits[2:]
+	}
+
+	country = normalizeCountry(country)
+	rule, ok := phoneRules[country]
+	if !ok {
+		rule = defaultPhoneRule
+	}
+
+	if rule.callingCode != "" {
+		switch {
+		case international:
+			if !strings.HasPrefix(digits, rule.callingCode) {
+				return Phone{}, validation.NewError("validation_phone_calling_code", "号码 {{.phone}} 的国际区号与国家 {{.country}}(+{{.callingCode}}) 不一致").SetParams(map[string]interface{}{"phone": raw, "country": country, "callingCode": rule.callingCode})
+			}
+			digits = digits[len(rule.callingCode):]
+		case strings.HasPrefix(digits, rule.callingCode) && len(digits)-len(rule.callingCode) >= rule.minLength:
+			digits = digits[len(rule.callingCode):]
+		}
+		if country == "MX" && len(digits) == 11 && strings.HasPrefix(digits, "1") {
+			// 墨西哥旧式手机号在国际区号后带有 1
+			digits = digits[1:]
+		}
+	}
+	if rule.trunkPrefix != "" && len(digits) > rule.minLength && strings.HasPrefix(digits, rule.trunkPrefix) {
+		digits = digits[len(rule.trunkPrefix):]
+	}
+
+	if len(digits) < rule.minLength || len(digits) > rule.maxLength {
+		if rule.minLength == rule.maxLength {
+			return Phone{}, validation.NewError("validation_phone_length", "号码 {{.phone}} 的国内号码必须为 {{.min}} 位数字, 当前为 {{.length}} 位").SetParams(map[string]interface{}{"phone": raw, "min": rule.minLength, "max": rule.maxLength, "length": len(digits)})
+		}
+		return Phone{}, validation.NewError("validation_phone_length", "号码 {{.phone}} 的国内号码必须为 {{.min}}-{{.max}} 位数字, 当前为 {{.length}} 位").SetParams(map[string]interface{}{"phone": raw, "min": rule.minLength, "max": rule.maxLength, "length": len(digits)})
+	}
+	if rule.pattern != nil && !rule.pattern.MatchString(digits) {
+		return Phone{}, validation.NewError("validation_phone_invalid", "号码 {{.phone}} 不是有效的 {{.country}} 电话号码").SetParams(map[string]interface{}{"phone": raw, "country": country})
+	}
+	return Phone{CountryCode: rule.callingCode, Number: digits, international: international && rule.callingCode == ""}, nil
+}
+
+// validPhone 电话号码校验规则, 号码必须可解析, 提交时会标准化为 GOFO 接受的格式
+// @param name 字段名称, 用于错误信息
+func validPhone(name, country string) validation.Rule {
+	return validation.By(func(value interface{}) error {
+		s, _ := value.(string)
+		if s == "" {
+			return nil
+		}
+		if _, err := ParsePhone(s, country); err != nil {
+			return validation.NewError("validation_phone_invalid", "{{.field}}无效: {{.reason}}").SetParams(map[string]interface{}{"field": name, "reason": err})
+		}
+		return nil
+	})
+}
+
+// NormalizePhone 将发件人手机号标准化为 GOFO 接受的格式, 无法解析时原样返回并返回错误
+func (m OrderShipper) NormalizePhone() (OrderShipper, error) {
+	phone, err := ParsePhone(m.ShipperPhone, m.ShipperCountry)
+	if err != nil {
+		return m, err
+	}
+	m.ShipperPhone = phone.String()
+	return m, nil
+}
+
+// NormalizePhone 将收件人手机号标准化为 GOFO 接受的格式, 未填写时原样返回, 无法解析时原样返回并返回错误
+func (m OrderConsignee) NormalizePhone() (OrderConsignee, error) {
+	if m.ConsigneePhone == "" {
+		return m, nil
+	}
+	phone, err := ParsePhone(m.ConsigneePhone, m.ConsigneeCountry)
+	if err != nil {
+		return m, err
+	}
+	m.ConsigneePhone = phone.String()
+	return m, nil
+}
+
+// MarshalJSON 提交时将可解析的手机号标准化为 GOFO 接受的格式
+func (m OrderShipper) MarshalJSON() ([]byte, error) {
+	type alias OrderShipper
+	m, _ = m.NormalizePhone()
+	return json.Marshal(alias(m))
+}
+
+// MarshalJSON 提交时将可解析的手机号标准化为 GOFO 接受的格式
+func (m OrderConsignee) MarshalJSON() ([]byte, error) {
+	type alias OrderConsignee
+	m, _ = m.NormalizePhone()
+	return json.Marshal(alias(m))
+}
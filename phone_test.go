@@ -0,0 +1,100 @@
+package gofo
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+func TestParsePhone(t *testing.T) {
+	tests := []struct {
+		raw, country string
+		e164         string
+		valid        bool
+	}{
+		{"+1 (555) 010-1234", "US", "+15550101234", true},
+		{"555.010.1234", "US", "+15550101234", true},
+		{"13000000000", "US", "+13000000000", true},
+		{"aaaaaaaaaa", "US", "", false},
+		{"+44 20 7946 0958", "US", "", false},
+		{"555-0101", "US", "", false},
+		{"13800138000", "CN", "+8613800138000", true},
+		{"+86 020 12345678", "CN", "+862012345678", true},
+		{"+52 1 55 1234 5678", "MX", "+525512345678", true},
+		{"0033 1 23 45 67 89", "FR", "+33123456789", true},
+		{"+52 1 55 1234 5678", "Mexico", "+525512345678", true},
+		{"13800138000", "China", "+8613800138000", true},
+		{"555.010.1234", "United States", "+15550101234", true},
+	}
+	for _, tt := range tests {
+		phone, err := ParsePhone(tt.raw, tt.country)
+		if (err == nil) != tt.valid {
+			t.Errorf("%s: expected valid %v, got %v", tt.raw, tt.valid, err)
+			continue
+		}
+		if tt.valid && phone.E164() != tt.e164 {
+			t.Errorf("%s: expected %s, got %s", tt.raw, tt.e164, phone.E164())
+		}
+	}
+}
+
+func TestOrderShipper_NormalizePhone(t *testing.T) {
+	shipper := OrderShipper{ShipperPhone: "+1 (555) 010-1234", ShipperCountry: "US"}
+	b, err := json.Marshal(shipper)
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !strings.Contains(string(b), `"shipperPhone":"5550101234"`) {
+		t.Errorf("Expected phone to be sent normalized, got %s", b)
+	}
+	b, err = json.Marshal(OrderConsignee{ConsigneePhone: "not a phone", ConsigneeCountry: "US"})
+	if err != nil || !strings.Contains(string(b), `"consigneePhone":"not a phone"`) {
+		t.Errorf("Expected unparsable phone to be sent as is, got %s, %v", b, err)
+	}
+
+	shipper, err = shipper.NormalizePhone()
+	if err != nil || shipper.ShipperPhone != "5550101234" {
+		t.Errorf("Expected normalized phone, got %s, %v", shipper.ShipperPhone, err)
+	}
+
+	phone, err := ParsePhone("1234567", "ZZ")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if phone.E164() != "" {
+		t.Errorf("Expected empty E.164 for unknown calling code, got %s", phone.E164())
+	}
+}
+
+func TestValidPhone(t *testing.T) {
+	rule := validPhone("发件人手机号", "US")
+	for raw, valid := range map[string]bool{
+		"5552101234":         true,
+		"+1 (555) 210-1234 ": true,
+		"555.210.1234":       true,
+		"15552101234":        true,
+		"+44 20 7946 0958":   false,
+		"555-0101":           false,
+	} {
+		if err := validation.Validate(raw, rule); (err == nil) != valid {
+			t.Errorf("%q: expected valid %v, got %v", raw, valid, err)
+		}
+	}
+}
+
+func TestOrderShipper_ValidateFormattedPhone(t *testing.T) {
+	shipper := OrderShipper{
+		ShipperName:    "Test",
+		ShipperPhone:   "+1 (555) 010-1234",
+		ShipperCountry: "US",
+		ShipperState:   "CA",
+		ShipperCity:    "Los Angeles",
+		ShipperStreet:  "123 Main St",
+		ShipperCode:    "90001",
+	}
+	if err := shipper.Validate(); err != nil {
+		t.Errorf("Expected formatted phone to be valid, got %v", err)
+	}
+}
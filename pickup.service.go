@@ -0,0 +1,187 @@
+package gofo
+
+import (
+	"context"
+	"time"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hiscaler/gofo-go/entity"
+	"gopkg.in/guregu/null.v4"
+)
+
+// 揽收预约服务
+type pickupService service
+
+// PickupWindow 揽收时间窗口
+type PickupWindow struct {
+	StartTime DateTime `json:"startTime"` // 揽收开始时间
+	EndTime   DateTime `json:"endTime"`   // 揽收结束时间, 需晚于揽收开始时间
+}
+
+func (m PickupWindow) Validate() error {
+	startRule, endRule := timeWindowRules("揽收", m.StartTime, m.EndTime, true)
+	return validation.ValidateStruct(&m,
+		validation.Field(&m.StartTime, startRule),
+		validation.Field(&m.EndTime, endRule),
+	)
+}
+
+// In 转换到指定时区
+func (m PickupWindow) In(loc *time.Location) PickupWindow {
+	return PickupWindow{StartTime: m.StartTime.In(loc), EndTime: m.EndTime.In(loc)}
+}
+
+// SchedulePickupRequest 预约揽收请求
+type SchedulePickupRequest struct {
+	WaybillNos   []string     `json:"waybillNos"`        // 运单号
+	OrderShipper OrderShipper `json:"orderShipper"`      // 揽收地址
+	Window       PickupWindow `json:"window"`            // 揽收时间窗口
+	Remarks      null.String  `json:"remarks,omitempty"` // 备注, 长度 1-100
+}
+
+func (m SchedulePickupRequest) Validate() error {
+	return validation.ValidateStruct(&m,
+		validation.Field(&m.WaybillNos, validation.Required.Error("运单号不能为空"), validation.Each(validation.Required.Error("运单号不能为空"))),
+		validation.Field(&m.OrderShipper),
+		validation.Field(&m.Window),
+		validation.Field(&m.Remarks, validation.When(m.Remarks.Valid, validation.Length(1, 100).Error("备注长度必须在 {{.min}}-{{.max}} 之间"))),
+	)
+}
+
+// pickupResponse GOFO 返回的揽收预约, 揽收时间格式为 yyyy-MM-dd HH:mm:ss 且不包含时区
+type pickupResponse struct {
+	entity.Pickup
+	StartTime DateTime `json:"startTime"`
+	EndTime   DateTime `json:"endTime"`
+}
+
+// pickup 转换为揽收预约, 揽收时间按指定时区解释
+func (r pickupResponse) pickup(loc *time.Location) entity.Pickup {
+	pickup := r.Pickup
+	pickup.StartTime = r.StartTime.In(loc).Time
+	pickup.EndTime = r.EndTime.In(loc).Time
+	return pickup
+}
+
+// Schedule 预约揽收
+func (s pickupService) Schedule(ctx context.Context, req SchedulePickupRequest) (entity.Pickup, error) {
+	// 先转换时区再校验, 以便不包含时区的揽收时间按客户端时区与当前时间比较
+	req.Window = req.Window.In(s.location)
+	if err := req.Validate(); err != nil {
+		return entity.Pickup{}, invalidInput(s.locale, err)
+	}
+
+	var res struct {
+		NormalResponse
+		Data pickupResponse `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetBody(req).
+		SetResult(&res).
+		Post("/open-api/v2/pickup/create")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return entity.Pickup{}, err
+	}
+	return res.Data.pickup(s.location), nil
+}
+
+// Reschedule 修改揽收时间
+// @param pickupId 揽收预约单号
+func (s pickupService) Reschedule(ctx context.Context, pickupId string, window PickupWindow) (entity.Pickup, error) {
+	if pickupId == "" {
+		return entity.Pickup{}, localizedError(s.locale, "揽收预约单号不能为空")
+	}
+	window = window.In(s.location)
+	if err := window.Validate(); err != nil {
+		return entity.Pickup{}, invalidInput(s.locale, err)
+	}
+
+	var res struct {
+		NormalResponse
+		Data pickupResponse `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetBody(map[string]any{
+			"pickupId": pickupId,
+			"window":   window,
+		}).
+		SetResult(&res).
+		Post("/open-api/v2/pickup/reschedule")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return entity.Pickup{}, err
+	}
+	return res.Data.pickup(s.location), nil
+}
+
+// Cancel 取消揽收预约
+// @param pickupId 揽收预约单号
+func (s pickupService) Cancel(ctx context.Context, pickupId string) (bool, error) {
+	if pickupId == "" {
+		return false, localizedError(s.locale, "揽收预约单号不能为空")
+	}
+
+	var res NormalResponse
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetBody(map[string]string{"pickupId": pickupId}).
+		SetResult(&res).
+		Post("/open-api/v2/pickup/cancel")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return false, err
+	}
+	return true, nil
+}
+
+// Status 揽收预约查询
+// @param pickupId 揽收预约单号
+func (s pickupService) Status(ctx context.Context, pickupId string) (entity.Pickup, error) {
+	if pickupId == "" {
+		return entity.Pickup{}, localizedError(s.locale, "揽收预约单号不能为空")
+	}
+
+	var res struct {
+		NormalResponse
+		Data pickupResponse `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetQueryParam("pickupId", pickupId).
+		SetResult(&res).
+		Get("/open-api/v2/pickup/detail")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return entity.Pickup{}, err
+	}
+	return res.Data.pickup(s.location), nil
+}
+
+// List 揽收预约列表
+// @param from 揽收开始时间起
+// @param to 揽收开始时间止
+func (s pickupService) List(ctx context.Context, from, to time.Time) ([]entity.Pickup, error) {
+	if !to.After(from) {
+		return nil, localizedError(s.locale, "查询结束时间必须晚于开始时间")
+	}
+
+	var res struct {
+		NormalResponse
+		Data []pickupResponse `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetQueryParams(map[string]string{
+			"startTime": NewDateTime(from).In(s.location).String(),
+			"endTime":   NewDateTime(to).In(s.location).String(),
+		}).
+		SetResult(&res).
+		Get("/open-api/v2/pickup/list")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return nil, err
+	}
+	pickups := make([]entity.Pickup, len(res.Data))
+	for i, r := range res.Data {
+		pickups[i] = r.pickup(s.location)
+	}
+	return pickups, nil
+}
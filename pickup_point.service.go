@@ -0,0 +1,40 @@
+package gofo
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/hiscaler/gofo-go/entity"
+)
+
+// 自提点服务
+type pickupPointService service
+
+// Search 自提点查询
+// @param postcode 邮编
+// @param radius 搜索半径, 单位: 公里, 范围 1-100
+func (s pickupPointService) Search(ctx context.Context, postcode string, radius float64) ([]entity.PickupPoint, error) {
+	if postcode == "" {
+		return nil, localizedError(s.locale, "邮编不能为空")
+	}
+	if radius < 1 || radius > 100 {
+		return nil, localizedError(s.locale, "搜索半径必须在 1-100 公里之间")
+	}
+
+	var res struct {
+		NormalResponse
+		Data []entity.PickupPoint `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetQueryParams(map[string]string{
+			"postcode": postcode,
+			"radius":   strconv.FormatFloat(radius, 'f', -1, 64),
+		}).
+		SetResult(&res).
+		Get("/open-api/v2/pickupPoint/search")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
@@ -0,0 +1,14 @@
+package gofo
+
+import (
+	"fmt"
+	"testing"
+)
+
+func TestPickupPointService_Search(t *testing.T) {
+	points, err := client.Services.PickupPoint.Search(ctx, "90001", 10)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	fmt.Println(points)
+}
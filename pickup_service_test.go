@@ -0,0 +1,68 @@
+package gofo
+
+import (
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestPickupService_Schedule(t *testing.T) {
+	start := time.Now().Add(24 * time.Hour)
+	req := SchedulePickupRequest{
+		WaybillNos: []string{"GFUS01014625997824"},
+		OrderShipper: OrderShipper{
+			ShipperName:    "test",
+			ShipperPhone:   "3000000000",
+			ShipperCountry: "US",
+			ShipperState:   "CA",
+			ShipperCity:    "Los Angeles",
+			ShipperStreet:  "test street",
+			ShipperCode:    "90058",
+		},
+		Window: PickupWindow{
+			StartTime: NewDateTime(start),
+			EndTime:   NewDateTime(start.Add(4 * time.Hour)),
+		},
+	}
+	pickup, err := client.Services.Pickup.Schedule(ctx, req)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	fmt.Println(pickup)
+}
+
+func TestPickupService_Status(t *testing.T) {
+	_, err := client.Services.Pickup.Status(ctx, "PK0001")
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestPickupService_Cancel(t *testing.T) {
+	_, err := client.Services.Pickup.Cancel(ctx, "PK0001")
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
+
+func TestPickupService_StatusTimes(t *testing.T) {
+	svc := newTestService(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, `{"code":200,"data":{"pickupId":"P1","startTime":"2024-03-01 09:00:00","endTime":"2024-03-01 17:00:00"}}`)
+	})
+	loc := time.FixedZone("PST", -8*3600)
+	svc.location = loc
+	s := pickupService(svc)
+
+	pickup, err := s.Status(ctx, "P1")
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if !pickup.StartTime.Equal(time.Date(2024, 3, 1, 9, 0, 0, 0, loc)) || !pickup.EndTime.Equal(time.Date(2024, 3, 1, 17, 0, 0, 0, loc)) {
+		t.Errorf("Unexpected pickup window %s - %s", pickup.StartTime, pickup.EndTime)
+	}
+	if pickup.PickupId != "P1" {
+		t.Errorf("Unexpected pickup %+v", pickup)
+	}
+}
@@ -0,0 +1,68 @@
+package gofo
+
+import (
+	"context"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hiscaler/gofo-go/entity"
+	"gopkg.in/guregu/null.v4"
+)
+
+// 运费服务
+type rateService service
+
+// QuoteRateRequest 运费试算请求
+type QuoteRateRequest struct {
+	ProductCode    null.String    `json:"productCode,omitempty"`  // 产品编码(长度 1-100), 不传时返回所有可用产品的报价
+	ShippingType   null.String    `json:"shippingType,omitempty"` // 配送类型: HDN(送货上门), ZT(自提), 默认为 HDN(送货上门)
+	DeclaredValue  float64        `json:"declaredValue"`          // 包裹预报货值, 单位: 美金, 范围 0.0001-100.00
+	EntryPort      string         `json:"entryPort,omitempty"`    // 入口岸
+	OrderShipper   OrderShipper   `json:"orderShipper"`           // 寄件信息
+	OrderConsignee OrderConsignee `json:"orderConsignee"`         // 收件信息
+	OrderGoods     OrderGoods     `json:"orderGoods"`             // 订单货物规格
+}
+
+func (m QuoteRateRequest) Validate() error {
+	return validation.ValidateStruct(&m,
+		validation.Field(&m.ProductCode, validation.When(m.ProductCode.Valid, validation.Length(1, 100).Error("产品编码长度必须在 {{.min}}-{{.max}} 之间"))),
+		validation.Field(&m.ShippingType, validation.When(m.ShippingType.Valid, validation.In(entity.ShippingTypeHDN, entity.ShippingTypeZT).Error("配送类型只能为 HDN 或 ZT"))),
+		validation.Field(&m.DeclaredValue, validation.Required.Error("包裹预报货值不能为空"), validation.Min(0.0001).Error("包裹预报货值不能小于 {{.threshold}}"), validation.Max(100.00).Error("包裹预报货值不能大于 {{.threshold}}")),
+		validation.Field(&m.OrderShipper),
+		validation.Field(&m.OrderConsignee),
+		validation.Field(&m.OrderGoods),
+	)
+}
+
+// QuoteRateRequestFromOrder 根据创建订单请求生成运费试算请求
+func QuoteRateRequestFromOrder(req CreateOrderRequest) QuoteRateRequest {
+	return QuoteRateRequest{
+		ProductCode:    req.ProductCode,
+		ShippingType:   req.ShippingType,
+		DeclaredValue:  req.DeclaredValue,
+		EntryPort:      req.EntryPort,
+		OrderShipper:   req.OrderShipper,
+		OrderConsignee: req.OrderConsignee,
+		OrderGoods:     req.OrderGoods,
+	}
+}
+
+// Quote 运费试算
+func (s rateService) Quote(ctx context.Context, req QuoteRateRequest) ([]entity.RateQuote, error) {
+	if err := req.Validate(); err != nil {
+		return nil, invalidInput(s.locale, err)
+	}
+
+	var res struct {
+		NormalResponse
+		Data []entity.RateQuote `json:"data"`
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetBody(req).
+		SetResult(&res).
+		Post("/open-api/v2/rate/quote")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return nil, err
+	}
+	return res.Data, nil
+}
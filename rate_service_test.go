@@ -0,0 +1,44 @@
+package gofo
+
+import (
+	"fmt"
+	"testing"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestRateService_Quote(t *testing.T) {
+	req := QuoteRateRequest{
+		ProductCode:   null.StringFrom("GOFO Parcel Pickup"),
+		DeclaredValue: 12,
+		OrderShipper: OrderShipper{
+			ShipperName:    "test",
+			ShipperPhone:   "13000000000",
+			ShipperCountry: "CN",
+			ShipperState:   "Guangdong",
+			ShipperCity:    "Shenzhen",
+			ShipperStreet:  "test street",
+			ShipperCode:    "518000",
+		},
+		OrderConsignee: OrderConsignee{
+			ConsigneeName:    "test",
+			ConsigneePhone:   "3000000000",
+			ConsigneeCountry: "US",
+			ConsigneeState:   "CA",
+			ConsigneeCity:    "Los Angeles",
+			Address1:         "test address",
+			ConsigneeCode:    "90001",
+		},
+		OrderGoods: OrderGoods{
+			Weight: 1,
+			Length: 1,
+			Height: 1,
+			Width:  1,
+		},
+	}
+	quotes, err := client.Services.Rate.Quote(ctx, req)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	fmt.Println(quotes)
+}
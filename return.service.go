@@ -0,0 +1,148 @@
+package gofo
+
+import (
+	"context"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/go-ozzo/ozzo-validation/v4/is"
+	"github.com/hiscaler/gofo-go/entity"
+	"gopkg.in/guregu/null.v4"
+)
+
+// 退货服务
+type returnService service
+
+// ReturnOptions 退货选项
+type ReturnOptions struct {
+	PickupAddress *OrderConsignee `json:"-"`                       // 退货揽收地址(消费者地址), 为空时使用原订单收件地址
+	ReturnAddress *OrderConsignee `json:"returnAddress,omitempty"` // 退货收件地址, 为空时退回原订单发件地址
+	OrderGoods    *OrderGoods     `json:"orderGoods,omitempty"`    // 退货货物规格, 为空时使用原订单货物规格
+	Reason        null.String     `json:"reason,omitempty"`        // 退货原因, 长度 1-100
+	LabelType     string          `json:"labelType"`               // 面单类型: PDF, QR, 默认为 PDF
+	NotifyEmail   null.String     `json:"notifyEmail,omitempty"`   // 接收退货面单的消费者邮箱
+}
+
+// pickupAddressFields 发件地址字段对应的揽收地址(收件地址)字段, 用于报告揽收地址的校验错误
+var pickupAddressFields = map[string]string{
+	"shipperName":    "consigneeName",
+	"shipperPhone":   "consigneePhone",
+	"shipperCountry": "consigneeCountry",
+	"shipperState":   "consigneeState",
+	"shipperCity":    "consigneeCity",
+	"shipperArea":    "consigneeArea",
+	"shipperStreet":  "address1",
+	"shipperCode":    "consigneeCode",
+	"shipperEmail":   "consigneeEmail",
+}
+
+func (m ReturnOptions) Validate() error {
+	err := validation.ValidateStruct(&m,
+		// 揽收地址会转换为发件地址提交, 需按发件人规则校验
+		validation.Field(&m.PickupAddress, validation.When(m.PickupAddress != nil, validation.By(func(interface{}) error {
+			return renameFields(returnShipper(*m.PickupAddress).Validate(), pickupAddressFields)
+		})), validation.Skip),
+		validation.Field(&m.ReturnAddress),
+		validation.Field(&m.OrderGoods),
+		validation.Field(&m.Reason, validation.When(m.Reason.Valid, validation.Length(1, 100).Error("退货原因长度必须在 {{.min}}-{{.max}} 之间"))),
+		validation.Field(&m.LabelType, validation.When(m.LabelType != "", validation.In(entity.ReturnLabelTypePDF, entity.ReturnLabelTypeQR).Error("面单类型只能为 PDF 或 QR"))),
+		validation.Field(&m.NotifyEmail, validation.When(m.NotifyEmail.Valid, is.EmailFormat.Error("消费者邮箱格式不正确"))),
+	)
+	return renameFields(err, map[string]string{"PickupAddress": "pickupAddress"})
+}
+
+// Create 创建退货订单
+// 退货订单由原订单反向生成(原收件人作为发件人), 并与原运单关联
+// @param originalOrderNo 原订单的 GOFO 运单号
+func (s returnService) Create(ctx context.Context, originalOrderNo string, opts ReturnOptions) (entity.ReturnOrder, error) {
+	if originalOrderNo == "" {
+		return entity.ReturnOrder{}, localizedError(s.locale, "原运单号不能为空")
+	}
+	if err := opts.Validate(); err != nil {
+		return entity.ReturnOrder{}, invalidInput(s.locale, err)
+	}
+	if opts.LabelType == "" {
+		opts.LabelType = entity.ReturnLabelTypePDF
+	}
+
+	var res struct {
+		NormalResponse
+		Data entity.ReturnOrder `json:"data"`
+	}
+	var pickupAddress *OrderShipper
+	if opts.PickupAddress != nil {
+		shipper := returnShipper(*opts.PickupAddress)
+		pickupAddress = &shipper
+	}
+	resp, err := s.httpClient.R().
+		SetContext(ctx).
+		SetBody(struct {
+			OriginalOrderNo string        `json:"originalOrderNo"`
+			PickupAddress   *OrderShipper `json:"pickupAddress,omitempty"`
+			ReturnOptions
+		}{originalOrderNo, pickupAddress, opts}).
+		SetResult(&res).
+		Post("/open-api/v2/return/create")
+	if err = recheckError(s.locale, resp, err); err != nil {
+		return entity.ReturnOrder{}, err
+	}
+	return res.Data, nil
+}
+
+// returnShipper 将消费者(原收件人)地址转换为退货发件地址
+// 发件地址只有一个详细地址字段, 外门牌号、内门牌号及地址 2、地址 3 会合并到详细地址中, 避免丢失公寓、套房等信息
+func returnShipper(consignee OrderConsignee) OrderShipper {
+	street := consignee.Address1
+	if consignee.ConsigneeNumExt.Valid && consignee.ConsigneeNumExt.String != "" {
+		street += " " + consignee.ConsigneeNumExt.String
+	}
+	if consignee.ConsigneeNumIn.Valid && consignee.ConsigneeNumIn.String != "" {
+		street += " Int. " + consignee.ConsigneeNumIn.String
+	}
+	lines := []string{strings.TrimSpace(street)}
+	for _, line := range []null.String{consignee.Address2, consignee.Address3} {
+		if line.Valid && strings.TrimSpace(line.String) != "" {
+			lines = append(lines, strings.TrimSpace(line.String))
+		}
+	}
+	return OrderShipper{
+		ShipperName:    consignee.ConsigneeName,
+		ShipperPhone:   consignee.ConsigneePhone,
+		ShipperCountry: consignee.ConsigneeCountry,
+		ShipperState:   consignee.ConsigneeState,
+		ShipperCity:    consignee.ConsigneeCity,
+		ShipperArea:    consignee.ConsigneeArea,
+		ShipperStreet:  strings.Join(lines, ", "),
+		ShipperCode:    consignee.ConsigneeCode,
+		ShipperEmail:   consignee.ConsigneeEmail,
+	}
+}
+
+// ReverseOrderRequest 根据原创建订单请求生成反向(退货)订单请求, 互换收发件地址
+// 客户单号、参考单号、揽收时间、保价信息及自提配送方式不会被复制, 退货订单从消费者处揽收
+func ReverseOrderRequest(original CreateOrderRequest) CreateOrderRequest {
+	shipper := original.OrderShipper
+	consignee := original.OrderConsignee
+	req := original
+	req.COrderNo = null.String{}
+	req.ReferenceNo = null.String{}
+	req.QueryCollectStartTime = DateTime{}
+	req.QueryCollectEndTime = DateTime{}
+	req.OrderInsurance = nil
+	req.ShippingType = null.String{}
+	req.PickupPointId = null.String{}
+	req.OrderShipper = returnShipper(consignee)
+	req.OrderConsignee = OrderConsignee{
+		ConsigneeName:    shipper.ShipperName,
+		ConsigneePhone:   shipper.ShipperPhone,
+		ConsigneeCountry: shipper.ShipperCountry,
+		ConsigneeState:   shipper.ShipperState,
+		ConsigneeCity:    shipper.ShipperCity,
+		ConsigneeArea:    shipper.ShipperArea,
+		Address1:         shipper.ShipperStreet,
+		ConsigneeCode:    shipper.ShipperCode,
+		ConsigneeEmail:   shipper.ShipperEmail,
+	}
+	req.OrderItemList = append([]OrderItem(nil), original.OrderItemList...)
+	return req
+}
@@ -0,0 +1,62 @@
+package gofo
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/hiscaler/gofo-go/entity"
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestReturnService_Create(t *testing.T) {
+	opts := ReturnOptions{
+		Reason:      null.StringFrom("wrong size"),
+		LabelType:   entity.ReturnLabelTypeQR,
+		NotifyEmail: null.StringFrom("test@example.com"),
+	}
+	order, err := client.Services.Return.Create(ctx, "GFUS01014625997824", opts)
+	if err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+	fmt.Println(order)
+}
+
+func TestReverseOrderRequest(t *testing.T) {
+	req := ReverseOrderRequest(CreateOrderRequest{
+		OrderConsignee: OrderConsignee{
+			ConsigneeName:   "test",
+			Address1:        "Av. Reforma",
+			ConsigneeNumExt: null.StringFrom("222"),
+			ConsigneeNumIn:  null.StringFrom("4B"),
+			Address2:        null.StringFrom("Col. Juarez"),
+		},
+	}.WithPickupPoint("P1"))
+	if req.OrderShipper.ShipperStreet != "Av. Reforma 222 Int. 4B, Col. Juarez" {
+		t.Errorf("Unexpected shipper street %q", req.OrderShipper.ShipperStreet)
+	}
+	if req.ShippingType.Valid || req.PickupPointId.Valid {
+		t.Errorf("Expected pickup point delivery not to be copied, got %s %s", req.ShippingType.String, req.PickupPointId.String)
+	}
+}
+
+func TestReturnOptions_Validate(t *testing.T) {
+	opts := ReturnOptions{PickupAddress: &OrderConsignee{
+		ConsigneeName:    "test",
+		ConsigneeCountry: "US",
+		ConsigneeState:   "CA",
+		ConsigneeCity:    "Los Angeles",
+		Address1:         strings.Repeat("a", 120),
+		ConsigneeCode:    "90001",
+	}}
+	if paths := errorPaths(t, opts.Validate()); !slices.Equal(paths, []string{"pickupAddress.address1", "pickupAddress.consigneePhone"}) {
+		t.Errorf("Expected pickup address phone and street errors, got %v", paths)
+	}
+
+	opts.PickupAddress.ConsigneePhone = "5552101234"
+	opts.PickupAddress.Address1 = "1 Main St"
+	if err := opts.Validate(); err != nil {
+		t.Errorf("Expected no error, got %v", err)
+	}
+}
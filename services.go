@@ -2,6 +2,7 @@ package gofo
 
 import (
 	"log/slog"
+	"time"
 
 	"github.com/go-resty/resty/v2"
 	"github.com/hiscaler/gofo-go/config"
@@ -11,9 +12,22 @@ type service struct {
 	config     *config.Config // Config
 	logger     *slog.Logger   // Logger
 	httpClient *resty.Client  // HTTP client
+	cache      *memoryCache   // Local cache
+	location   *time.Location // 提交日期时间时使用的时区
+	locale     string         // 校验及错误信息的语言
 }
 
 // API Services
 type services struct {
-	Order orderService // 订单服务
+	Order       orderService       // 订单服务
+	Rate        rateService        // 运费服务
+	Coverage    coverageService    // 服务范围服务
+	Address     addressService     // 地址服务
+	Pickup      pickupService      // 揽收预约服务
+	Manifest    manifestService    // 交接清单服务
+	Return      returnService      // 退货服务
+	Billing     billingService     // 账单服务
+	Catalog     catalogService     // 产品目录服务
+	Claims      claimService       // 索赔服务
+	PickupPoint pickupPointService // 自提点服务
 }
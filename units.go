@@ -0,0 +1,95 @@
+package gofo
+
+import (
+	"math"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+)
+
+// LengthUnit 长度单位
+type LengthUnit string
+
+const (
+	LengthUnitCM   LengthUnit = "CM"   // 厘米
+	LengthUnitM    LengthUnit = "M"    // 米
+	LengthUnitInch LengthUnit = "INCH" // 英寸
+)
+
+// ParseLengthUnit 解析长度单位(不区分大小写), 为空时视为厘米
+func ParseLengthUnit(s string) (LengthUnit, error) {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "", "CM":
+		return LengthUnitCM, nil
+	case "M":
+		return LengthUnitM, nil
+	case "INCH":
+		return LengthUnitInch, nil
+	}
+	return "", validation.NewError("validation_length_unit_invalid", "无效的长度单位 {{.unit}}, 只能为 CM、M 或 INCH").SetParams(map[string]interface{}{"unit": s})
+}
+
+// ToCentimeters 转换为厘米
+func (u LengthUnit) ToCentimeters(value float64) float64 {
+	switch u {
+	case LengthUnitM:
+		return value * 100
+	case LengthUnitInch:
+		return value * 2.54
+	}
+	return value
+}
+
+// WeightUnit 重量单位
+type WeightUnit string
+
+const (
+	WeightUnitKG WeightUnit = "KG" // 千克
+	WeightUnitLB WeightUnit = "LB" // 磅
+)
+
+// ParseWeightUnit 解析重量单位(不区分大小写), 为空时视为千克
+func ParseWeightUnit(s string) (WeightUnit, error) {
+	switch strings.ToUpper(strings.TrimSpace(s)) {
+	case "", "KG":
+		return WeightUnitKG, nil
+	case "LB":
+		return WeightUnitLB, nil
+	}
+	return "", validation.NewError("validation_weight_unit_invalid", "无效的重量单位 {{.unit}}, 只能为 KG 或 LB").SetParams(map[string]interface{}{"unit": s})
+}
+
+// strictUnit 严格解析单位, 仅接受空值或标准写法(大写、不含空格)
+func strictUnit[T ~string](parse func(string) (T, error), s string) (T, error) {
+	unit, err := parse(s)
+	if err == nil && s != "" && string(unit) != s {
+		err = validation.NewError("validation_unit_not_canonical", "单位 {{.unit}} 必须为标准写法 {{.canonical}}").SetParams(map[string]interface{}{"unit": s, "canonical": string(unit)})
+	}
+	return unit, err
+}
+
+// ToKilograms 转换为千克
+func (u WeightUnit) ToKilograms(value float64) float64 {
+	if u == WeightUnitLB {
+		return value * 0.45359237
+	}
+	return value
+}
+
+// toKilograms 将重量转换为千克, 单位为空或无效时视为千克
+func toKilograms(value float64, unit string) float64 {
+	u, _ := ParseWeightUnit(unit)
+	return u.ToKilograms(value)
+}
+
+// toCentimeters 将长度转换为厘米, 单位为空或无效时视为厘米
+func toCentimeters(value float64, unit string) float64 {
+	u, _ := ParseLengthUnit(unit)
+	return u.ToCentimeters(value)
+}
+
+// round 按指定小数位数四舍五入
+func round(value float64, precision int) float64 {
+	p := math.Pow10(precision)
+	return math.Round(value*p) / p
+}
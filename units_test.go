@@ -0,0 +1,69 @@
+package gofo
+
+import (
+	"errors"
+	"testing"
+
+	"gopkg.in/guregu/null.v4"
+)
+
+func TestOrderGoods_Validate(t *testing.T) {
+	tests := []struct {
+		name  string
+		goods OrderGoods
+		valid bool
+	}{
+		{"150 LB", OrderGoods{Weight: 150, Length: 10, Width: 10, Height: 10, WeightUnit: null.StringFrom("LB")}, true},
+		{"150 KG", OrderGoods{Weight: 150, Length: 10, Width: 10, Height: 10}, false},
+		{"10 M", OrderGoods{Weight: 1, Length: 10, Width: 0.5, Height: 0.5, LengthUnit: null.StringFrom("M")}, false},
+		{"500 INCH", OrderGoods{Weight: 1, Length: 500, Width: 10, Height: 10, LengthUnit: null.StringFrom("INCH")}, false},
+		{"invalid unit", OrderGoods{Weight: 1, Length: 10, Width: 10, Height: 10, WeightUnit: null.StringFrom("TON")}, false},
+		{"lowercase unit", OrderGoods{Weight: 1, Length: 10, Width: 10, Height: 10, WeightUnit: null.StringFrom("kg")}, false},
+		{"padded unit", OrderGoods{Weight: 1, Length: 10, Width: 10, Height: 10, LengthUnit: null.StringFrom(" cm")}, false},
+		{"normalized unit", OrderGoods{Weight: 1, Length: 10, Width: 10, Height: 10, LengthUnit: null.StringFrom(" cm")}.Normalize(), true},
+		{"normalized invalid unit", OrderGoods{Weight: 2, Length: 3, Width: 10, Height: 10, LengthUnit: null.StringFrom("FT"), WeightUnit: null.StringFrom("OZ")}.Normalize(), false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.goods.Validate()
+			if (err == nil) != tt.valid {
+				t.Errorf("Expected valid %v, got %v", tt.valid, err)
+			}
+		})
+	}
+}
+
+func TestOrderGoods_ValidateUnitMessage(t *testing.T) {
+	goods := OrderGoods{Weight: 1, Length: 10, Width: 10, Height: 10, LengthUnit: null.StringFrom("cm"), WeightUnit: null.StringFrom("TON")}
+	var ve *ValidationError
+	if !errors.As(invalidInput(LocaleEnUS, goods.Validate()), &ve) || len(ve.Fields) != 2 {
+		t.Fatalf("Expected 2 field errors, got %v", ve)
+	}
+	length, weight := ve.Fields[0], ve.Fields[1]
+	if length.Path != "lengthUnit" || length.Rule != "validation_unit_not_canonical" || length.Message != "Unit cm must be written as CM" {
+		t.Errorf("Unexpected field error %+v", length)
+	}
+	if weight.Path != "weightUnit" || weight.Rule != "validation_weight_unit_invalid" || weight.Message != "Invalid weight unit TON, must be KG or LB" {
+		t.Errorf("Unexpected field error %+v", weight)
+	}
+}
+
+func TestGoodsFromInches(t *testing.T) {
+	goods := GoodsFromInches(10, 5, 2, 2)
+	if goods.Length != 25.4 || goods.Width != 12.7 || goods.Height != 5.08 || goods.Weight != 0.907 {
+		t.Errorf("Unexpected goods %+v", goods)
+	}
+	if goods.LengthUnit.String != "CM" || goods.WeightUnit.String != "KG" {
+		t.Errorf("Expected CM/KG units, got %s/%s", goods.LengthUnit.String, goods.WeightUnit.String)
+	}
+}
+
+func TestOrderGoods_Normalize(t *testing.T) {
+	goods := OrderGoods{Weight: 2, Length: 3, Width: 1, Height: 1, LengthUnit: null.StringFrom("FT"), WidthUnit: null.StringFrom("m"), WeightUnit: null.StringFrom("OZ")}.Normalize()
+	if goods.Weight != 2 || goods.WeightUnit.String != "OZ" || goods.Length != 3 || goods.LengthUnit.String != "FT" {
+		t.Errorf("Expected invalid units to be kept, got %+v", goods)
+	}
+	if goods.Width != 100 || goods.WidthUnit.String != "CM" || goods.HeightUnit.String != "CM" {
+		t.Errorf("Expected valid units to be converted, got %+v", goods)
+	}
+}
@@ -0,0 +1,185 @@
+package gofo
+
+import (
+	"regexp"
+	"strconv"
+	"strings"
+
+	validation "github.com/go-ozzo/ozzo-validation/v4"
+	"github.com/hiscaler/gofo-go/entity"
+)
+
+// usStates 美国州、特区、海外领地及军邮地区的 USPS 代码与名称
+var usStates = map[string]string{
+	"AL": "Alabama", "AK": "Alaska", "AZ": "Arizona", "AR": "Arkansas", "CA": "California",
+	"CO": "Colorado", "CT": "Connecticut", "DE": "Delaware", "FL": "Florida", "GA": "Georgia",
+	"HI": "Hawaii", "ID": "Idaho", "IL": "Illinois", "IN": "Indiana", "IA": "Iowa",
+	"KS": "Kansas", "KY": "Kentucky", "LA": "Louisiana", "ME": "Maine", "MD": "Maryland",
+	"MA": "Massachusetts", "MI": "Michigan", "MN": "Minnesota", "MS": "Mississippi", "MO": "Missouri",
+	"MT": "Montana", "NE": "Nebraska", "NV": "Nevada", "NH": "New Hampshire", "NJ": "New Jersey",
+	"NM": "New Mexico", "NY": "New York", "NC": "North Carolina", "ND": "North Dakota", "OH": "Ohio",
+	"OK": "Oklahoma", "OR": "Oregon", "PA": "Pennsylvania", "RI": "Rhode Island", "SC": "South Carolina",
+	"SD": "South Dakota", "TN": "Tennessee", "TX": "Texas", "UT": "Utah", "VT": "Vermont",
+	"VA": "Virginia", "WA": "Washington", "WV": "West Virginia", "WI": "Wisconsin", "WY": "Wyoming",
+	"DC": "District of Columbia", "PR": "Puerto Rico", "VI": "Virgin Islands", "GU": "Guam",
+	"AS": "American Samoa", "MP": "Northern Mariana Islands",
+	"AA": "Armed Forces Americas", "AE": "Armed Forces Europe", "AP": "Armed Forces Pacific",
+}
+
+// usStateAliases 常见的州名缩写(AP 格式等), 键为去除句点后的大写形式
+var usStateAliases = map[string]string{
+	"ALA": "AL", "ARIZ": "AZ", "ARK": "AR", "CALIF": "CA", "CAL": "CA", "COLO": "CO", "CONN": "CT",
+	"DEL": "DE", "FLA": "FL", "ILL": "IL", "IND": "IN", "KAN": "KS", "KANS": "KS", "MASS": "MA",
+	"MICH": "MI", "MINN": "MN", "MISS": "MS", "MONT": "MT", "NEB": "NE", "NEBR": "NE", "NEV": "NV",
+	"OKLA": "OK", "ORE": "OR", "OREG": "OR", "PENN": "PA", "PENNA": "PA", "TENN": "TN", "TEX": "TX",
+	"WASH": "WA", "W VA": "WV", "WIS": "WI", "WISC": "WI", "WYO": "WY", "D C": "DC",
+	"WASHINGTON DC": "DC", "WASHINGTON D C": "DC",
+}
+
+// usZipPrefixes 邮编前三位与州的对应关系
+var usZipPrefixes = []struct {
+	from, to int
+	state    string
+}{
+	{5, 5, "NY"}, {6, 7, "PR"}, {8, 8, "VI"}, {9, 9, "PR"}, {10, 27, "MA"}, {28, 29, "RI"},
+	{30, 38, "NH"}, {39, 49, "ME"}, {50, 54, "VT"}, {55, 55, "MA"}, {56, 59, "VT"}, {60, 69, "CT"},
+	{70, 89, "NJ"}, {90, 98, "AE"}, {100, 149, "NY"}, {150, 196, "PA"}, {197, 199, "DE"},
+	{200, 200, "DC"}, {201, 201, "VA"}, {202, 205, "DC"}, {206, 219, "MD"}, {220, 246, "VA"},
+	{247, 268, "WV"}, {270, 289, "NC"}, {290, 299, "SC"}, {300, 319, "GA"}, {320, 339, "FL"},
+	{340, 340, "AA"}, {341, 349, "FL"}, {350, 369, "AL"}, {370, 385, "TN"}, {386, 397, "MS"},
+	{398, 399, "GA"}, {400, 427, "KY"}, {430, 459, "OH"}, {460, 479, "IN"}, {480, 499, "MI"},
+	{500, 528, "IA"}, {530, 549, "WI"}, {550, 567, "MN"}, {569, 569, "DC"}, {570, 577, "SD"},
+	{580, 588, "ND"}, {590, 599, "MT"}, {600, 629, "IL"}, {630, 658, "MO"}, {660, 679, "KS"},
+	{680, 693, "NE"}, {700, 714, "LA"}, {716, 729, "AR"}, {730, 732, "OK"}, {733, 733, "TX"},
+	{734, 749, "OK"}, {750, 799, "TX"}, {800, 816, "CO"}, {820, 831, "WY"}, {832, 838, "ID"},
+	{840, 847, "UT"}, {850, 865, "AZ"}, {870, 884, "NM"}, {885, 885, "TX"}, {889, 898, "NV"},
+	{900, 961, "CA"}, {962, 966, "AP"}, {967, 968, "HI"}, {969, 969, "GU"}, {970, 979, "OR"},
+	{980, 994, "WA"}, {995, 999, "AK"},
+}
+
+// usZipOverrides 与所在前三位不属于同一州的五位邮编区间, 优先于 usZipPrefixes 匹配
+var usZipOverrides = []struct {
+	from, to int
+	state    string
+}{
+	{6390, 6390, "NY"}, {73960, 73960, "TX"}, {83414, 83414, "WY"}, {96799, 96799, "AS"}, {96950, 96952, "MP"},
+}
+
+// usZipAmbiguous 跨越州界的五位邮编, 无法仅凭邮编确定所属州(不完整, 仅收录常见的跨州邮编)
+var usZipAmbiguous = map[string]bool{
+	"20135": true, "42223": true, "57638": true, "59221": true, "69201": true, "71749": true, "73949": true,
+	"81137": true, "82063": true, "84536": true, "86515": true, "88063": true, "89439": true, "97635": true,
+	"99362": true,
+}
+
+var usZipRegexp = regexp.MustCompile(`^\d{5}(-\d{4})?$`)
+
+// isUSCountry 是否为美国
+func isUSCountry(country string) bool {
+	switch strings.ToUpper(strings.TrimSpace(country)) {
+	case "US", "USA", "UNITED STATES", "UNITED STATES OF AMERICA":
+		return true
+	}
+	return false
+}
+
+// USStateCode 将美国州名称或缩写转换为 USPS 两位代码, 例如 California、Calif.、ca 都将返回 CA
+func USStateCode(state string) (string, bool) {
+	key := strings.ToUpper(strings.Join(strings.Fields(strings.ReplaceAll(state, ".", " ")), " "))
+	if _, ok := usStates[key]; ok {
+		return key, true
+	}
+	if code, ok := usStateAliases[key]; ok {
+		return code, true
+	}
+	for code, name := range usStates {
+		if strings.EqualFold(name, key) {
+			return code, true
+		}
+	}
+	return "", false
+}
+
+// USZipState 根据邮编获取所属州, 优先按五位邮编匹配, 其次按前三位匹配, 未知或邮编跨越州界时返回 false
+func USZipState(zip string) (string, bool) {
+	if len(zip) < 3 {
+		return "", false
+	}
+	if len(zip) >= 5 {
+		if usZipAmbiguous[zip[:5]] {
+			return "", false
+		}
+		if code, err := strconv.Atoi(zip[:5]); err == nil {
+			for _, o := range usZipOverrides {
+				if code >= o.from && code <= o.to {
+					return o.state, true
+				}
+			}
+		}
+	}
+	prefix, err := strconv.Atoi(zip[:3])
+	if err != nil || prefix < 0 {
+		return "", false
+	}
+	for _, p := range usZipPrefixes {
+		if prefix >= p.from && prefix <= p.to {
+			return p.state, true
+		}
+	}
+	return "", false
+}
+
+// normalizeUSAddress 标准化美国地址的国家、州及邮编, 返回标准化后的值及修改记录
+// 邮编格式不正确、州无法识别或邮编与州不一致时返回错误
+func normalizeUSAddress(prefix, country, state, zip string) (string, string, string, []entity.AddressCorrection, error) {
+	corrections := make([]entity.AddressCorrection, 0)
+	change := func(field, original, normalized string) string {
+		if original != normalized {
+			corrections = append(corrections, entity.AddressCorrection{Field: field, Original: original, Corrected: normalized, Safe: true})
+		}
+		return normalized
+	}
+
+	country = change(prefix+"Country", country, "US")
+	code, ok := USStateCode(state)
+	if !ok {
+		return country, state, zip, corrections, validation.NewError("validation_us_state_unknown", "无法识别的美国州 {{.state}}").SetParams(map[string]interface{}{"state": state})
+	}
+	state = change(prefix+"State", state, code)
+
+	normalizedZip := strings.TrimSpace(zip)
+	if digits := strings.ReplaceAll(normalizedZip, " ", ""); len(digits) == 9 && !strings.Contains(digits, "-") {
+		normalizedZip = digits[:5] + "-" + digits[5:]
+	}
+	if !usZipRegexp.MatchString(normalizedZip) {
+		return country, state, zip, corrections, validation.NewError("validation_us_zip_format", "美国邮编 {{.zip}} 格式不正确, 必须为 5 位数字或 ZIP+4 格式").SetParams(map[string]interface{}{"zip": zip})
+	}
+	zip = change(prefix+"Code", zip, normalizedZip)
+
+	if zipState, ok := USZipState(zip); ok && zipState != state {
+		return country, state, zip, corrections, validation.NewError("validation_us_zip_state_mismatch", "邮编 {{.zip}} 属于 {{.zipState}}, 与州 {{.state}} 不一致").SetParams(map[string]interface{}{"zip": zip, "zipState": zipState, "state": state})
+	}
+	return country, state, zip, corrections, nil
+}
+
+// NormalizeUS 标准化美国收件地址: 将州名称转换为 USPS 代码, 校验邮编格式及邮编与州是否一致
+// 非美国地址原样返回, 返回值中包含所有修改记录
+func (m OrderConsignee) NormalizeUS() (OrderConsignee, []entity.AddressCorrection, error) {
+	if !isUSCountry(m.ConsigneeCountry) {
+		return m, nil, nil
+	}
+	country, state, zip, corrections, err := normalizeUSAddress("consignee", m.ConsigneeCountry, m.ConsigneeState, m.ConsigneeCode)
+	m.ConsigneeCountry, m.ConsigneeState, m.ConsigneeCode = country, state, zip
+	return m, corrections, err
+}
+
+// NormalizeUS 标准化美国发件地址: 将州名称转换为 USPS 代码, 校验邮编格式及邮编与州是否一致
+// 非美国地址原样返回, 返回值中包含所有修改记录
+func (m OrderShipper) NormalizeUS() (OrderShipper, []entity.AddressCorrection, error) {
+	if !isUSCountry(m.ShipperCountry) {
+		return m, nil, nil
+	}
+	country, state, zip, corrections, err := normalizeUSAddress("shipper", m.ShipperCountry, m.ShipperState, m.ShipperCode)
+	m.ShipperCountry, m.ShipperState, m.ShipperCode = country, state, zip
+	return m, corrections, err
+}
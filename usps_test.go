@@ -0,0 +1,81 @@
+package gofo
+
+import (
+	"testing"
+)
+
+func TestUSStateCode(t *testing.T) {
+	for state, expected := range map[string]string{
+		"California": "CA",
+		"Calif.":     "CA",
+		"ca":         "CA",
+		"new  york":  "NY",
+		"W. Va.":     "WV",
+	} {
+		if code, ok := USStateCode(state); !ok || code != expected {
+			t.Errorf("%s: expected %s, got %s", state, expected, code)
+		}
+	}
+	if _, ok := USStateCode("Guangdong"); ok {
+		t.Errorf("Expected Guangdong to be unknown")
+	}
+}
+
+func TestOrderConsignee_NormalizeUS(t *testing.T) {
+	consignee := OrderConsignee{
+		ConsigneeCountry: "USA",
+		ConsigneeState:   "California",
+		ConsigneeCode:    "900011234",
+	}
+	normalized, corrections, err := consignee.NormalizeUS()
+	if err != nil {
+		t.Fatalf("Expected no error, got %v", err)
+	}
+	if normalized.ConsigneeCountry != "US" || normalized.ConsigneeState != "CA" || normalized.ConsigneeCode != "90001-1234" {
+		t.Errorf("Unexpected consignee %+v", normalized)
+	}
+	if len(corrections) != 3 {
+		t.Errorf("Expected 3 corrections, got %v", corrections)
+	}
+
+	consignee.ConsigneeState = "NY"
+	if _, _, err = consignee.NormalizeUS(); err == nil {
+		t.Errorf("Expected ZIP and state mismatch error")
+	} else if s := localizeError(LocaleEnUS, err).Error(); s != "ZIP code 90001-1234 belongs to CA, which does not match state NY" {
+		t.Errorf("Unexpected en-US error: %s", s)
+	}
+
+	consignee.ConsigneeState, consignee.ConsigneeCode = "American Samoa", "96799"
+	if _, _, err = consignee.NormalizeUS(); err != nil {
+		t.Errorf("Expected American Samoa ZIP to be valid, got %v", err)
+	}
+
+	consignee.ConsigneeCode = "9000"
+	if _, _, err = consignee.NormalizeUS(); err == nil {
+		t.Errorf("Expected invalid ZIP error")
+	}
+}
+
+func TestUSZipState(t *testing.T) {
+	for zip, expected := range map[string]string{
+		"96813":      "HI",
+		"96799":      "AS",
+		"96950":      "MP",
+		"96952-0001": "MP",
+		"96910":      "GU",
+		"90001":      "CA",
+		"83414":      "WY",
+		"83401":      "ID",
+		"06390":      "NY",
+		"73960":      "TX",
+	} {
+		if state, ok := USZipState(zip); !ok || state != expected {
+			t.Errorf("%s: expected %s, got %s", zip, expected, state)
+		}
+	}
+	for _, zip := range []string{"ab", "97635", "89439-1234"} {
+		if _, ok := USZipState(zip); ok {
+			t.Errorf("Expected %s to be unknown", zip)
+		}
+	}
+}